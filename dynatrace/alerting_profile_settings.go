@@ -0,0 +1,218 @@
+package dynatrace
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// alertingProfileSettingsV2 manages dynatrace_alerting_profiles through the settings schema builtin:alerting.profile,
+// which supersedes /alertingProfiles of the Config v1 API.
+var alertingProfileSettingsV2 = &legacySettingsResource{
+	SchemaID:           "builtin:alerting.profile",
+	NameAttribute:      "display_name",
+	LegacyPath:         "/alertingProfiles",
+	LegacyNameProperty: "displayName",
+	Expand:             expandAlertingProfileSettingsV2Value,
+	Flatten:            flattenAlertingProfileSettingsV2Value,
+}
+
+func expandAlertingProfileSettingsV2Value(d *schema.ResourceData) (map[string]interface{}, error) {
+	value := map[string]interface{}{
+		"name":          d.Get("display_name").(string),
+		"severityRules": expandAlertingProfileSettingsV2Rules(d.Get("rule").([]interface{})),
+		"eventFilters":  expandAlertingProfileSettingsV2EventFilters(d.Get("event_type_filter").([]interface{})),
+	}
+
+	if mzID := d.Get("mz_id").(string); mzID != "" {
+		value["managementZone"] = mzID
+	}
+
+	return value, nil
+}
+
+func expandAlertingProfileSettingsV2Rules(rules []interface{}) []interface{} {
+	srs := []interface{}{}
+
+	for _, rule := range rules {
+		i := rule.(map[string]interface{})
+
+		sr := map[string]interface{}{
+			"severityLevel":        i["severity_level"].(string),
+			"delayInMinutes":       i["delay_in_minutes"].(int),
+			"tagFilterIncludeMode": "NONE",
+			"tagFilter":            []interface{}{},
+		}
+
+		if tf := i["tag_filters"].([]interface{}); len(tf) > 0 && tf[0] != nil {
+			tagFilter := tf[0].(map[string]interface{})
+			if includeMode := tagFilter["include_mode"].(string); includeMode != "" {
+				sr["tagFilterIncludeMode"] = includeMode
+			}
+
+			tags := []interface{}{}
+			for _, tag := range tagFilter["tag_filter"].([]interface{}) {
+				t := tag.(map[string]interface{})
+				tags = append(tags, formatSettingsTag(t["context"].(string), t["key"].(string), t["value"].(string)))
+			}
+			sr["tagFilter"] = tags
+		}
+
+		srs = append(srs, sr)
+	}
+
+	return srs
+}
+
+func expandAlertingProfileSettingsV2EventFilters(typeFilters []interface{}) []interface{} {
+	efs := []interface{}{}
+
+	for _, typeFilter := range typeFilters {
+		if typeFilter == nil {
+			continue
+		}
+		m := typeFilter.(map[string]interface{})
+
+		if pef := m["predefined_event_filter"].([]interface{}); len(pef) > 0 && pef[0] != nil {
+			predefinedEventFilter := pef[0].(map[string]interface{})
+			efs = append(efs, map[string]interface{}{
+				"type": "PREDEFINED",
+				"predefinedFilter": map[string]interface{}{
+					"eventType": predefinedEventFilter["event_type"].(string),
+					"negate":    predefinedEventFilter["negate"].(bool),
+				},
+			})
+		}
+
+		if cef := m["custom_event_filter"].([]interface{}); len(cef) > 0 && cef[0] != nil {
+			customEventFilter := cef[0].(map[string]interface{})
+			customFilter := map[string]interface{}{}
+			if titleFilter := expandAlertingProfileSettingsV2TextFilter(customEventFilter["custom_title_filter"].([]interface{})); titleFilter != nil {
+				customFilter["titleFilter"] = titleFilter
+			}
+			if descriptionFilter := expandAlertingProfileSettingsV2TextFilter(customEventFilter["custom_description_filter"].([]interface{})); descriptionFilter != nil {
+				customFilter["descriptionFilter"] = descriptionFilter
+			}
+			efs = append(efs, map[string]interface{}{
+				"type":         "CUSTOM",
+				"customFilter": customFilter,
+			})
+		}
+	}
+
+	return efs
+}
+
+func expandAlertingProfileSettingsV2TextFilter(customTextFilter []interface{}) map[string]interface{} {
+	if len(customTextFilter) == 0 || customTextFilter[0] == nil {
+		return nil
+	}
+
+	m := customTextFilter[0].(map[string]interface{})
+
+	// Settings 2.0 has the inverse flag of case_insensitive
+	return map[string]interface{}{
+		"enabled":       m["enabled"].(bool),
+		"value":         m["value"].(string),
+		"operator":      m["operator"].(string),
+		"negate":        m["negate"].(bool),
+		"caseSensitive": !m["case_insensitive"].(bool),
+	}
+}
+
+func flattenAlertingProfileSettingsV2Value(d *schema.ResourceData, value map[string]interface{}) error {
+	if err := d.Set("rule", flattenAlertingProfileSettingsV2RulesData(settingsSlice(value, "severityRules"))); err != nil {
+		return err
+	}
+
+	if err := d.Set("event_type_filter", flattenAlertingProfileSettingsV2EventFiltersData(settingsSlice(value, "eventFilters"))); err != nil {
+		return err
+	}
+
+	d.Set("display_name", settingsString(value, "name"))
+	d.Set("mz_id", settingsString(value, "managementZone"))
+
+	return nil
+}
+
+func flattenAlertingProfileSettingsV2RulesData(severityRules []interface{}) []interface{} {
+	ars := make([]interface{}, 0, len(severityRules))
+
+	for _, severityRule := range severityRules {
+		sr, ok := severityRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tfs := []interface{}{}
+		for _, tag := range settingsSlice(sr, "tagFilter") {
+			context, key, value := parseSettingsTag(tag.(string))
+			tfs = append(tfs, map[string]interface{}{
+				"context": context,
+				"key":     key,
+				"value":   value,
+			})
+		}
+
+		ar := make(map[string]interface{})
+
+		ar["severity_level"] = settingsString(sr, "severityLevel")
+		ar["delay_in_minutes"] = settingsInt(sr, "delayInMinutes")
+		ar["tag_filters"] = []interface{}{map[string]interface{}{
+			"include_mode": settingsString(sr, "tagFilterIncludeMode"),
+			"tag_filter":   tfs,
+		}}
+		ars = append(ars, ar)
+	}
+
+	return ars
+}
+
+func flattenAlertingProfileSettingsV2EventFiltersData(eventFilters []interface{}) []interface{} {
+	efs := make([]interface{}, 0, len(eventFilters))
+
+	for _, eventFilter := range eventFilters {
+		f, ok := eventFilter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ef := make(map[string]interface{})
+		ef["predefined_event_filter"] = []interface{}{}
+		ef["custom_event_filter"] = []interface{}{}
+
+		switch settingsString(f, "type") {
+		case "PREDEFINED":
+			predefinedFilter := settingsMap(f, "predefinedFilter")
+			ef["predefined_event_filter"] = []interface{}{map[string]interface{}{
+				"event_type": settingsString(predefinedFilter, "eventType"),
+				"negate":     settingsBool(predefinedFilter, "negate"),
+			}}
+		case "CUSTOM":
+			customFilter := settingsMap(f, "customFilter")
+			ef["custom_event_filter"] = []interface{}{map[string]interface{}{
+				"custom_title_filter":       flattenAlertingProfileSettingsV2TextFilter(settingsMap(customFilter, "titleFilter")),
+				"custom_description_filter": flattenAlertingProfileSettingsV2TextFilter(settingsMap(customFilter, "descriptionFilter")),
+			}}
+		}
+
+		efs = append(efs, ef)
+	}
+
+	return efs
+}
+
+func flattenAlertingProfileSettingsV2TextFilter(textFilter map[string]interface{}) []interface{} {
+	// Settings 2.0 always returns both text filters, unused ones are disabled and empty
+	if textFilter == nil || (!settingsBool(textFilter, "enabled") && settingsString(textFilter, "value") == "") {
+		return []interface{}{}
+	}
+
+	ctf := make(map[string]interface{})
+
+	ctf["enabled"] = settingsBool(textFilter, "enabled")
+	ctf["value"] = settingsString(textFilter, "value")
+	ctf["operator"] = settingsString(textFilter, "operator")
+	ctf["negate"] = settingsBool(textFilter, "negate")
+	ctf["case_insensitive"] = !settingsBool(textFilter, "caseSensitive")
+
+	return []interface{}{ctf}
+}
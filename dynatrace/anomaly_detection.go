@@ -0,0 +1,523 @@
+package dynatrace
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// responseTimeDegradationDetection is the response time degradation detection of services, applications and databases.
+// Unlike ResponseTimeDegradationDetectionConfig of the official client it omits the automatic detection and the thresholds
+// if they do not apply to the detection mode.
+type responseTimeDegradationDetection struct {
+	DetectionMode      string                                                        `json:"detectionMode"`
+	AutomaticDetection *dynatraceConfigV1.ResponseTimeDegradationAutodetectionConfig `json:"automaticDetection,omitempty"`
+	Thresholds         *dynatraceConfigV1.ResponseTimeDegradationThresholdConfig     `json:"thresholds,omitempty"`
+}
+
+// failureRateIncreaseDetection is the failure rate increase detection of services, applications and databases.
+// Unlike FailureRateIncreaseDetectionConfig of the official client it omits the automatic detection and the thresholds
+// if they do not apply to the detection mode.
+type failureRateIncreaseDetection struct {
+	DetectionMode      string                                                    `json:"detectionMode"`
+	AutomaticDetection *dynatraceConfigV1.FailureRateIncreaseAutodetectionConfig `json:"automaticDetection,omitempty"`
+	Thresholds         *dynatraceConfigV1.FailureRateIncreaseThresholdConfig     `json:"thresholds,omitempty"`
+}
+
+// defaultResponseTimeDegradationDetection is the Dynatrace default of the response time degradation detection.
+var defaultResponseTimeDegradationDetection = responseTimeDegradationDetection{
+	DetectionMode: "DETECT_AUTOMATICALLY",
+	AutomaticDetection: &dynatraceConfigV1.ResponseTimeDegradationAutodetectionConfig{
+		ResponseTimeDegradationMilliseconds:        100,
+		ResponseTimeDegradationPercent:             50,
+		SlowestResponseTimeDegradationMilliseconds: 1000,
+		SlowestResponseTimeDegradationPercent:      100,
+		LoadThreshold:                              "TEN_REQUESTS_PER_MINUTE",
+	},
+}
+
+// defaultFailureRateIncreaseDetection is the Dynatrace default of the failure rate increase detection.
+var defaultFailureRateIncreaseDetection = failureRateIncreaseDetection{
+	DetectionMode: "DETECT_AUTOMATICALLY",
+	AutomaticDetection: &dynatraceConfigV1.FailureRateIncreaseAutodetectionConfig{
+		FailingServiceCallPercentageIncreaseAbsolute: 5,
+		FailingServiceCallPercentageIncreaseRelative: 50,
+	},
+}
+
+// customThresholdDetection is a detection of the host, VMware or AWS anomaly detection. Unlike the detection configs of the
+// official client it omits the custom thresholds if the detection uses the automatically adjusted thresholds.
+type customThresholdDetection struct {
+	Enabled          bool        `json:"enabled"`
+	CustomThresholds interface{} `json:"customThresholds,omitempty"`
+}
+
+var anomalyDetectionModes = []string{"DETECT_AUTOMATICALLY", "DETECT_USING_FIXED_THRESHOLDS", "DONT_DETECT"}
+
+func responseTimeDegradationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "Configuration of response time degradation detection.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"detection_mode": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "How to detect response time degradation: DETECT_AUTOMATICALLY, DETECT_USING_FIXED_THRESHOLDS or DONT_DETECT.",
+					ValidateFunc: validation.StringInSlice(anomalyDetectionModes, false),
+				},
+				"automatic_detection": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Parameters of the response time degradation auto-detection. Required if the detection_mode is DETECT_AUTOMATICALLY. Violation of any criterion triggers an alert.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"response_time_degradation_milliseconds": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Alert if the median response time degrades by more than X milliseconds.",
+							},
+							"response_time_degradation_percent": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Alert if the median response time degrades by more than X %.",
+							},
+							"slowest_response_time_degradation_milliseconds": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Alert if the response time of the slowest 10% (90th percentile) degrades by more than X milliseconds.",
+							},
+							"slowest_response_time_degradation_percent": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Alert if the response time of the slowest 10% (90th percentile) degrades by more than X %.",
+							},
+							"load_threshold": &schema.Schema{
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Minimal load to detect response time degradation, e.g. ONE_REQUEST_PER_MINUTE, FIVE_REQUESTS_PER_MINUTE, TEN_REQUESTS_PER_MINUTE or FIFTEEN_REQUESTS_PER_MINUTE.",
+							},
+						},
+					},
+				},
+				"thresholds": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Fixed thresholds for response time degradation detection. Required if detection_mode is DETECT_USING_FIXED_THRESHOLDS.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"response_time_threshold_milliseconds": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Median response time during any 5-minute period to trigger an alert, in milliseconds.",
+							},
+							"slowest_response_time_threshold_milliseconds": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Response time of the 10% slowest (90th percentile) during any 5-minute period to trigger an alert, in milliseconds.",
+							},
+							"load_threshold": &schema.Schema{
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Minimal load to detect response time degradation.",
+							},
+							"sensitivity": &schema.Schema{
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Sensitivity of the threshold, either LOW, MEDIUM or HIGH.",
+								ValidateFunc: validation.StringInSlice([]string{"LOW", "MEDIUM", "HIGH"}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func failureRateIncreaseSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "Configuration of failure rate increase detection.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"detection_mode": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "How to detect failure rate increase: DETECT_AUTOMATICALLY, DETECT_USING_FIXED_THRESHOLDS or DONT_DETECT.",
+					ValidateFunc: validation.StringInSlice(anomalyDetectionModes, false),
+				},
+				"automatic_detection": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Parameters of failure rate increase auto-detection. Required if detection_mode is DETECT_AUTOMATICALLY. The absolute and relative thresholds both must exceed to trigger an alert.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"failing_service_call_percentage_increase_absolute": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Absolute increase of failing service calls to trigger an alert, %.",
+							},
+							"failing_service_call_percentage_increase_relative": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Relative increase of failing service calls to trigger an alert, %.",
+							},
+						},
+					},
+				},
+				"thresholds": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Fixed thresholds for failure rate increase detection. Required if detection_mode is DETECT_USING_FIXED_THRESHOLDS.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"threshold": &schema.Schema{
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Failure rate during any 5-minute period to trigger an alert, %.",
+							},
+							"sensitivity": &schema.Schema{
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Sensitivity of the threshold, either LOW, MEDIUM or HIGH.",
+								ValidateFunc: validation.StringInSlice([]string{"LOW", "MEDIUM", "HIGH"}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func loadDropSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The configuration of load drops detection. Disabled if not set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": &schema.Schema{
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "The detection is enabled (true) or disabled (false).",
+				},
+				"load_drop_percent": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Alert if the observed load is less than X % of the expected value.",
+				},
+				"min_abnormal_state_duration_in_minutes": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Alert if the service stays in abnormal state for at least X minutes.",
+				},
+			},
+		},
+	}
+}
+
+func loadSpikeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The configuration of load spikes detection. Disabled if not set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": &schema.Schema{
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "The detection is enabled (true) or disabled (false).",
+				},
+				"load_spike_percent": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Alert if the observed load is more than X % of the expected value.",
+				},
+				"min_abnormal_state_duration_in_minutes": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Alert if the service stays in abnormal state for at least X minutes.",
+				},
+			},
+		},
+	}
+}
+
+func expandResponseTimeDegradation(responseTimeDegradation []interface{}) responseTimeDegradationDetection {
+	if len(responseTimeDegradation) == 0 || responseTimeDegradation[0] == nil {
+		return responseTimeDegradationDetection{}
+	}
+
+	m := responseTimeDegradation[0].(map[string]interface{})
+
+	rtd := responseTimeDegradationDetection{
+		DetectionMode: m["detection_mode"].(string),
+	}
+
+	if v := m["automatic_detection"].([]interface{}); len(v) != 0 && v[0] != nil && rtd.DetectionMode == "DETECT_AUTOMATICALLY" {
+		ad := v[0].(map[string]interface{})
+
+		rtd.AutomaticDetection = &dynatraceConfigV1.ResponseTimeDegradationAutodetectionConfig{
+			ResponseTimeDegradationMilliseconds:        int32(ad["response_time_degradation_milliseconds"].(int)),
+			ResponseTimeDegradationPercent:             int32(ad["response_time_degradation_percent"].(int)),
+			SlowestResponseTimeDegradationMilliseconds: int32(ad["slowest_response_time_degradation_milliseconds"].(int)),
+			SlowestResponseTimeDegradationPercent:      int32(ad["slowest_response_time_degradation_percent"].(int)),
+			LoadThreshold:                              ad["load_threshold"].(string),
+		}
+	}
+
+	if v := m["thresholds"].([]interface{}); len(v) != 0 && v[0] != nil && rtd.DetectionMode == "DETECT_USING_FIXED_THRESHOLDS" {
+		t := v[0].(map[string]interface{})
+
+		rtd.Thresholds = &dynatraceConfigV1.ResponseTimeDegradationThresholdConfig{
+			ResponseTimeThresholdMilliseconds:        int32(t["response_time_threshold_milliseconds"].(int)),
+			SlowestResponseTimeThresholdMilliseconds: int32(t["slowest_response_time_threshold_milliseconds"].(int)),
+			LoadThreshold:                            t["load_threshold"].(string),
+			Sensitivity:                              t["sensitivity"].(string),
+		}
+	}
+
+	return rtd
+}
+
+func expandFailureRateIncrease(failureRateIncrease []interface{}) failureRateIncreaseDetection {
+	if len(failureRateIncrease) == 0 || failureRateIncrease[0] == nil {
+		return failureRateIncreaseDetection{}
+	}
+
+	m := failureRateIncrease[0].(map[string]interface{})
+
+	fri := failureRateIncreaseDetection{
+		DetectionMode: m["detection_mode"].(string),
+	}
+
+	if v := m["automatic_detection"].([]interface{}); len(v) != 0 && v[0] != nil && fri.DetectionMode == "DETECT_AUTOMATICALLY" {
+		ad := v[0].(map[string]interface{})
+
+		fri.AutomaticDetection = &dynatraceConfigV1.FailureRateIncreaseAutodetectionConfig{
+			FailingServiceCallPercentageIncreaseAbsolute: int32(ad["failing_service_call_percentage_increase_absolute"].(int)),
+			FailingServiceCallPercentageIncreaseRelative: int32(ad["failing_service_call_percentage_increase_relative"].(int)),
+		}
+	}
+
+	if v := m["thresholds"].([]interface{}); len(v) != 0 && v[0] != nil && fri.DetectionMode == "DETECT_USING_FIXED_THRESHOLDS" {
+		t := v[0].(map[string]interface{})
+
+		fri.Thresholds = &dynatraceConfigV1.FailureRateIncreaseThresholdConfig{
+			Threshold:   int32(t["threshold"].(int)),
+			Sensitivity: t["sensitivity"].(string),
+		}
+	}
+
+	return fri
+}
+
+func expandLoadDrop(loadDrop []interface{}) *dynatraceConfigV1.LoadDropDetectionConfig {
+	if len(loadDrop) == 0 || loadDrop[0] == nil {
+		return &dynatraceConfigV1.LoadDropDetectionConfig{Enabled: false}
+	}
+
+	m := loadDrop[0].(map[string]interface{})
+
+	return &dynatraceConfigV1.LoadDropDetectionConfig{
+		Enabled:                           m["enabled"].(bool),
+		LoadDropPercent:                   int32(m["load_drop_percent"].(int)),
+		MinAbnormalStateDurationInMinutes: int32(m["min_abnormal_state_duration_in_minutes"].(int)),
+	}
+}
+
+func expandLoadSpike(loadSpike []interface{}) *dynatraceConfigV1.LoadSpikeDetectionConfig {
+	if len(loadSpike) == 0 || loadSpike[0] == nil {
+		return &dynatraceConfigV1.LoadSpikeDetectionConfig{Enabled: false}
+	}
+
+	m := loadSpike[0].(map[string]interface{})
+
+	return &dynatraceConfigV1.LoadSpikeDetectionConfig{
+		Enabled:                           m["enabled"].(bool),
+		LoadSpikePercent:                  int32(m["load_spike_percent"].(int)),
+		MinAbnormalStateDurationInMinutes: int32(m["min_abnormal_state_duration_in_minutes"].(int)),
+	}
+}
+
+func flattenResponseTimeDegradation(responseTimeDegradation *dynatraceConfigV1.ResponseTimeDegradationDetectionConfig) []interface{} {
+	if responseTimeDegradation == nil {
+		return []interface{}{responseTimeDegradation}
+	}
+
+	r := make(map[string]interface{})
+
+	r["detection_mode"] = responseTimeDegradation.DetectionMode
+	r["automatic_detection"] = make([]interface{}, 0)
+	r["thresholds"] = make([]interface{}, 0)
+
+	switch responseTimeDegradation.DetectionMode {
+	case "DETECT_AUTOMATICALLY":
+		ad := make(map[string]interface{})
+
+		ad["response_time_degradation_milliseconds"] = responseTimeDegradation.AutomaticDetection.ResponseTimeDegradationMilliseconds
+		ad["response_time_degradation_percent"] = responseTimeDegradation.AutomaticDetection.ResponseTimeDegradationPercent
+		ad["slowest_response_time_degradation_milliseconds"] = responseTimeDegradation.AutomaticDetection.SlowestResponseTimeDegradationMilliseconds
+		ad["slowest_response_time_degradation_percent"] = responseTimeDegradation.AutomaticDetection.SlowestResponseTimeDegradationPercent
+		ad["load_threshold"] = responseTimeDegradation.AutomaticDetection.LoadThreshold
+
+		r["automatic_detection"] = []interface{}{ad}
+	case "DETECT_USING_FIXED_THRESHOLDS":
+		t := make(map[string]interface{})
+
+		t["response_time_threshold_milliseconds"] = responseTimeDegradation.Thresholds.ResponseTimeThresholdMilliseconds
+		t["slowest_response_time_threshold_milliseconds"] = responseTimeDegradation.Thresholds.SlowestResponseTimeThresholdMilliseconds
+		t["load_threshold"] = responseTimeDegradation.Thresholds.LoadThreshold
+		t["sensitivity"] = responseTimeDegradation.Thresholds.Sensitivity
+
+		r["thresholds"] = []interface{}{t}
+	}
+
+	return []interface{}{r}
+}
+
+func flattenFailureRateIncrease(failureRateIncrease *dynatraceConfigV1.FailureRateIncreaseDetectionConfig) []interface{} {
+	if failureRateIncrease == nil {
+		return []interface{}{failureRateIncrease}
+	}
+
+	f := make(map[string]interface{})
+
+	f["detection_mode"] = failureRateIncrease.DetectionMode
+	f["automatic_detection"] = make([]interface{}, 0)
+	f["thresholds"] = make([]interface{}, 0)
+
+	switch failureRateIncrease.DetectionMode {
+	case "DETECT_AUTOMATICALLY":
+		ad := make(map[string]interface{})
+
+		ad["failing_service_call_percentage_increase_absolute"] = failureRateIncrease.AutomaticDetection.FailingServiceCallPercentageIncreaseAbsolute
+		ad["failing_service_call_percentage_increase_relative"] = failureRateIncrease.AutomaticDetection.FailingServiceCallPercentageIncreaseRelative
+
+		f["automatic_detection"] = []interface{}{ad}
+	case "DETECT_USING_FIXED_THRESHOLDS":
+		t := make(map[string]interface{})
+
+		t["threshold"] = failureRateIncrease.Thresholds.Threshold
+		t["sensitivity"] = failureRateIncrease.Thresholds.Sensitivity
+
+		f["thresholds"] = []interface{}{t}
+	}
+
+	return []interface{}{f}
+}
+
+// flattenLoadDrop flattens a disabled detection to a disabled block if the previous state has one, so a configured
+// block with enabled = false doesn't show a diff. The API doesn't return the thresholds of a disabled detection, they
+// are kept from the previous state.
+func flattenLoadDrop(loadDrop *dynatraceConfigV1.LoadDropDetectionConfig, previous []interface{}) []interface{} {
+	if loadDrop == nil || !loadDrop.Enabled {
+		if len(previous) == 0 || previous[0] == nil {
+			return make([]interface{}, 0)
+		}
+
+		l := previous[0].(map[string]interface{})
+		l["enabled"] = false
+
+		return []interface{}{l}
+	}
+
+	l := make(map[string]interface{})
+
+	l["enabled"] = loadDrop.Enabled
+	l["load_drop_percent"] = loadDrop.LoadDropPercent
+	l["min_abnormal_state_duration_in_minutes"] = loadDrop.MinAbnormalStateDurationInMinutes
+
+	return []interface{}{l}
+}
+
+// flattenLoadSpike flattens a disabled detection to a disabled block if the previous state has one, so a configured
+// block with enabled = false doesn't show a diff. The API doesn't return the thresholds of a disabled detection, they
+// are kept from the previous state.
+func flattenLoadSpike(loadSpike *dynatraceConfigV1.LoadSpikeDetectionConfig, previous []interface{}) []interface{} {
+	if loadSpike == nil || !loadSpike.Enabled {
+		if len(previous) == 0 || previous[0] == nil {
+			return make([]interface{}, 0)
+		}
+
+		l := previous[0].(map[string]interface{})
+		l["enabled"] = false
+
+		return []interface{}{l}
+	}
+
+	l := make(map[string]interface{})
+
+	l["enabled"] = loadSpike.Enabled
+	l["load_spike_percent"] = loadSpike.LoadSpikePercent
+	l["min_abnormal_state_duration_in_minutes"] = loadSpike.MinAbnormalStateDurationInMinutes
+
+	return []interface{}{l}
+}
+
+func customThresholdDetectionSchema(description string, customThresholds map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": &schema.Schema{
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "The detection is enabled (true) or disabled (false).",
+				},
+				"custom_thresholds": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Custom thresholds of the detection. The automatically adjusted thresholds are used if not set.",
+					Elem: &schema.Resource{
+						Schema: customThresholds,
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandCustomThresholdDetection returns whether the detection is enabled and its custom thresholds, nil if not set.
+func expandCustomThresholdDetection(detection []interface{}) (bool, map[string]interface{}) {
+	if len(detection) == 0 || detection[0] == nil {
+		return false, nil
+	}
+
+	m := detection[0].(map[string]interface{})
+
+	if v := m["custom_thresholds"].([]interface{}); len(v) != 0 && v[0] != nil {
+		return m["enabled"].(bool), v[0].(map[string]interface{})
+	}
+
+	return m["enabled"].(bool), nil
+}
+
+func flattenCustomThresholdDetection(enabled bool, customThresholds map[string]interface{}) []interface{} {
+	c := make(map[string]interface{})
+
+	c["enabled"] = enabled
+	c["custom_thresholds"] = make([]interface{}, 0)
+	if customThresholds != nil {
+		c["custom_thresholds"] = []interface{}{customThresholds}
+	}
+
+	return []interface{}{c}
+}
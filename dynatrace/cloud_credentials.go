@@ -0,0 +1,67 @@
+package dynatrace
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudCredentialsTag is a tag of the monitored resources shared by the AWS and Azure credentials.
+type cloudCredentialsTag struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+func cloudCredentialsTagsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    10,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The key of the tag.",
+				},
+				"value": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value of the tag. If not set, resources with any value of the tag are monitored.",
+				},
+			},
+		},
+	}
+}
+
+func expandCloudCredentialsTags(tags []interface{}) []cloudCredentialsTag {
+	cts := make([]cloudCredentialsTag, 0, len(tags))
+
+	for _, tag := range tags {
+		m := tag.(map[string]interface{})
+
+		cts = append(cts, cloudCredentialsTag{
+			Name:  m["name"].(string),
+			Value: m["value"].(string),
+		})
+	}
+
+	return cts
+}
+
+func flattenCloudCredentialsTags(tags *[]cloudCredentialsTag) []interface{} {
+	if tags != nil {
+		cts := make([]interface{}, len(*tags), len(*tags))
+
+		for i, tag := range *tags {
+			ct := make(map[string]interface{})
+
+			ct["name"] = tag.Name
+			ct["value"] = tag.Value
+			cts[i] = ct
+		}
+
+		return cts
+	}
+
+	return make([]interface{}, 0)
+}
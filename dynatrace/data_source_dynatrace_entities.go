@@ -0,0 +1,101 @@
+package dynatrace
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDynatraceEntities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDynatraceEntitiesRead,
+		Schema: map[string]*schema.Schema{
+			"entity_selector": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity selector of the entities, e.g. type(\"HOST\"),tag(\"production\").",
+			},
+			"from": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start of the timeframe the entities were observed in, e.g. now-3d. Defaults to now-3d.",
+			},
+			"to": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the timeframe the entities were observed in, e.g. now. Defaults to now.",
+			},
+			"fields": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional fields of the entities, e.g. +properties or +properties.osType. Tags and management zones are always returned.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the matching entities.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"entities": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: entitySchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDynatraceEntitiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "entities.read"); diags.HasError() {
+		return diags
+	}
+
+	entitySelector := d.Get("entity_selector").(string)
+	from := d.Get("from").(string)
+	to := d.Get("to").(string)
+	fields := expandStringList(d.Get("fields").([]interface{}))
+
+	entities, err := listEntities(ctx, dynatraceEnvRestClientV2, entityQuery(entitySelector, from, to, fields))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace entities",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ids := make([]interface{}, len(entities), len(entities))
+	fes := make([]interface{}, len(entities), len(entities))
+	for i, e := range entities {
+		ids[i] = e.EntityID
+		fes[i] = flattenEntity(&e)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("entities", fes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{"entities", entitySelector, from, to}, "/"))
+
+	return diags
+}
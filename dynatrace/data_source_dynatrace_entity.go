@@ -0,0 +1,110 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// entitySelectorEscaper escapes the special characters of values in entity selectors.
+var entitySelectorEscaper = strings.NewReplacer("~", "~~", "\"", "~\"")
+
+func dataSourceDynatraceEntity() *schema.Resource {
+	entityDataSourceSchema := entitySchema()
+
+	entityDataSourceSchema["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of the entity, e.g. SERVICE, HOST or PROCESS_GROUP.",
+	}
+	entityDataSourceSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The exact name of the entity.",
+		ExactlyOneOf: []string{"name", "name_regex"},
+	}
+	entityDataSourceSchema["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "A regular expression the name of the entity must match.",
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDynatraceEntityRead,
+		Schema:      entityDataSourceSchema,
+	}
+}
+
+func dataSourceDynatraceEntityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "entities.read"); diags.HasError() {
+		return diags
+	}
+
+	entityType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	entitySelector := fmt.Sprintf("type(\"%s\")", entitySelectorEscaper.Replace(entityType))
+	if name != "" {
+		entitySelector += fmt.Sprintf(",entityName.equals(\"%s\")", entitySelectorEscaper.Replace(name))
+	}
+
+	entities, err := listEntities(ctx, dynatraceEnvRestClientV2, entityQuery(entitySelector, "", "", []string{"+properties"}))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace entity",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// Entity selectors don't support regular expressions, the entities of the type are filtered instead
+	if nameRegex != "" {
+		re := regexp.MustCompile(nameRegex)
+
+		matching := []entity{}
+		for _, e := range entities {
+			if re.MatchString(e.DisplayName) {
+				matching = append(matching, e)
+			}
+		}
+		entities = matching
+	}
+
+	if len(entities) != 1 {
+		ids := make([]string, len(entities), len(entities))
+		for i, e := range entities {
+			ids[i] = e.EntityID
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to find a single dynatrace entity",
+			Detail:   fmt.Sprintf("Expected exactly one entity matching %s, found %d: %s", entitySelector, len(entities), strings.Join(ids, ", ")),
+		})
+		return diags
+	}
+
+	for attribute, value := range flattenEntity(&entities[0]) {
+		if err := d.Set(attribute, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(entities[0].EntityID)
+
+	return diags
+}
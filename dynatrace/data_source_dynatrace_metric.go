@@ -0,0 +1,179 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// metricDescriptor is the metadata of a metric as returned by the Environment v2 API /metrics/{metricSelector}.
+type metricDescriptor struct {
+	MetricID             string                      `json:"metricId"`
+	DisplayName          string                      `json:"displayName"`
+	Description          string                      `json:"description"`
+	Unit                 string                      `json:"unit"`
+	AggregationTypes     []string                    `json:"aggregationTypes"`
+	Transformations      []string                    `json:"transformations"`
+	DefaultAggregation   metricDefaultAggregation    `json:"defaultAggregation"`
+	DimensionDefinitions []metricDimensionDefinition `json:"dimensionDefinitions"`
+	EntityType           []string                    `json:"entityType"`
+}
+
+type metricDefaultAggregation struct {
+	Type string `json:"type"`
+}
+
+type metricDimensionDefinition struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Index       int    `json:"index"`
+	Type        string `json:"type"`
+}
+
+func dataSourceDynatraceMetric() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDynatraceMetricRead,
+		Schema: map[string]*schema.Schema{
+			"metric_selector": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the metric, e.g. builtin:service.response.time. The data source fails if the metric doesn't exist.",
+			},
+			"metric_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aggregation_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"transformations": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_aggregation": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entity_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dimension_definitions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDynatraceMetricRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "metrics.read"); diags.HasError() {
+		return diags
+	}
+
+	metricSelector := d.Get("metric_selector").(string)
+
+	var metric metricDescriptor
+	err := dynatraceEnvRestClientV2.get(ctx, "/metrics/"+url.PathEscape(metricSelector), &metric)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace metric",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("metric_id", metric.MetricID)
+	d.Set("display_name", metric.DisplayName)
+	d.Set("description", metric.Description)
+	d.Set("unit", metric.Unit)
+	d.Set("aggregation_types", metric.AggregationTypes)
+	d.Set("transformations", metric.Transformations)
+	d.Set("default_aggregation", metric.DefaultAggregation.Type)
+	d.Set("entity_types", metric.EntityType)
+
+	if err := d.Set("dimension_definitions", flattenMetricDimensionDefinitions(&metric.DimensionDefinitions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(metric.MetricID)
+
+	return diags
+}
+
+func flattenMetricDimensionDefinitions(dimensionDefinitions *[]metricDimensionDefinition) []interface{} {
+	if dimensionDefinitions != nil {
+		dds := make([]interface{}, len(*dimensionDefinitions), len(*dimensionDefinitions))
+
+		for i, dimensionDefinition := range *dimensionDefinitions {
+			dd := make(map[string]interface{})
+
+			dd["key"] = dimensionDefinition.Key
+			dd["name"] = dimensionDefinition.Name
+			dd["display_name"] = dimensionDefinition.DisplayName
+			dd["index"] = dimensionDefinition.Index
+			dd["type"] = dimensionDefinition.Type
+			dds[i] = dd
+		}
+
+		return dds
+	}
+
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,227 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// metricQueryResult is a page of the Environment v2 API /metrics/query.
+type metricQueryResult struct {
+	NextPageKey string              `json:"nextPageKey"`
+	Resolution  string              `json:"resolution"`
+	Result      []metricSeriesGroup `json:"result"`
+}
+
+type metricSeriesGroup struct {
+	MetricID string         `json:"metricId"`
+	Data     []metricSeries `json:"data"`
+}
+
+type metricSeries struct {
+	Dimensions   []string          `json:"dimensions"`
+	DimensionMap map[string]string `json:"dimensionMap"`
+	Timestamps   []int64           `json:"timestamps"`
+	Values       []*float64        `json:"values"`
+}
+
+func dataSourceDynatraceMetricQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDynatraceMetricQueryRead,
+		Schema: map[string]*schema.Schema{
+			"metric_selector": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The metric selector of the query, e.g. builtin:service.response.time:avg:splitBy(\"dt.entity.service\").",
+			},
+			"entity_selector": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The entity selector to restrict the entities of the query, e.g. type(\"SERVICE\"),tag(\"production\").",
+			},
+			"from": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start of the timeframe, e.g. now-2h. Defaults to now-2h.",
+			},
+			"to": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The end of the timeframe, e.g. now. Defaults to now.",
+			},
+			"resolution": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The resolution of the data points, e.g. 1h or Inf for a single data point. Defaults to 120 data points.",
+			},
+			"resolved_resolution": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resolution of the returned data points.",
+			},
+			"result": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"dimension_map": &schema.Schema{
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"timestamps": &schema.Schema{
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The timestamps of the data points in UTC milliseconds.",
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+									"values": &schema.Schema{
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The values of the data points. Data points without a value are omitted together with their timestamp.",
+										Elem: &schema.Schema{
+											Type: schema.TypeFloat,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDynatraceMetricQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "metrics.read"); diags.HasError() {
+		return diags
+	}
+
+	metricSelector := d.Get("metric_selector").(string)
+	entitySelector := d.Get("entity_selector").(string)
+	from := d.Get("from").(string)
+	to := d.Get("to").(string)
+	resolution := d.Get("resolution").(string)
+
+	query := url.Values{"metricSelector": {metricSelector}}
+	if entitySelector != "" {
+		query.Set("entitySelector", entitySelector)
+	}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+	if resolution != "" {
+		query.Set("resolution", resolution)
+	}
+
+	result := metricQueryResult{}
+	for {
+		var page metricQueryResult
+		err := dynatraceEnvRestClientV2.get(ctx, "/metrics/query?"+query.Encode(), &page)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to query dynatrace metrics",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		result.Resolution = page.Resolution
+		result.Result = append(result.Result, page.Result...)
+
+		if page.NextPageKey == "" {
+			break
+		}
+
+		// The next page key contains all other parameters of the query
+		query = url.Values{"nextPageKey": {page.NextPageKey}}
+	}
+
+	d.Set("resolved_resolution", result.Resolution)
+
+	if err := d.Set("result", flattenMetricSeriesGroups(&result.Result)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{"metric_query", metricSelector, entitySelector, from, to, resolution}, "/"))
+
+	return diags
+}
+
+func flattenMetricSeriesGroups(seriesGroups *[]metricSeriesGroup) []interface{} {
+	if seriesGroups != nil {
+		sgs := make([]interface{}, len(*seriesGroups), len(*seriesGroups))
+
+		for i, seriesGroup := range *seriesGroups {
+			sg := make(map[string]interface{})
+
+			sg["metric_id"] = seriesGroup.MetricID
+			sg["data"] = flattenMetricSeries(&seriesGroup.Data)
+			sgs[i] = sg
+		}
+
+		return sgs
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenMetricSeries(series *[]metricSeries) []interface{} {
+	mss := make([]interface{}, len(*series), len(*series))
+
+	for i, s := range *series {
+		ms := make(map[string]interface{})
+
+		timestamps := []interface{}{}
+		values := []interface{}{}
+		for j, value := range s.Values {
+			if value == nil || j >= len(s.Timestamps) {
+				continue
+			}
+			timestamps = append(timestamps, int(s.Timestamps[j]))
+			values = append(values, *value)
+		}
+
+		ms["dimensions"] = s.Dimensions
+		ms["dimension_map"] = s.DimensionMap
+		ms["timestamps"] = timestamps
+		ms["values"] = values
+		mss[i] = ms
+	}
+
+	return mss
+}
@@ -0,0 +1,169 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type syntheticLocations struct {
+	Locations []syntheticLocation `json:"locations"`
+}
+
+func dataSourceDynatraceSyntheticLocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDynatraceSyntheticLocationsRead,
+		Schema: map[string]*schema.Schema{
+			"cloud_platform": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return locations hosted on the given cloud platform, e.g. AWS, AZURE, ALIBABA, GOOGLE_CLOUD or OTHER.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return locations of the given type, either PUBLIC, PRIVATE or CLUSTER.",
+				ValidateFunc: validation.StringInSlice([]string{"PUBLIC", "PRIVATE", "CLUSTER"}, false),
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return locations with the given name.",
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The entity IDs of the matching locations, keyed by location name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"locations": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_platform": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ips": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDynatraceSyntheticLocationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	cloudPlatform := d.Get("cloud_platform").(string)
+	locationType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	query := url.Values{}
+	if cloudPlatform != "" {
+		query.Set("cloudPlatform", cloudPlatform)
+	}
+	if locationType != "" {
+		query.Set("type", locationType)
+	}
+
+	path := "/synthetic/locations"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var locations syntheticLocations
+	err := dynatraceEnvRestClientV1.get(ctx, path, &locations)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace synthetic locations",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	matching := []syntheticLocation{}
+	for _, location := range locations.Locations {
+		if name == "" || location.Name == name {
+			matching = append(matching, location)
+		}
+	}
+
+	if err := d.Set("locations", flattenSyntheticLocations(&matching)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make(map[string]interface{})
+	for _, location := range matching {
+		ids[location.Name] = location.EntityID
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{"synthetic_locations", cloudPlatform, locationType, name}, "/"))
+
+	return diags
+}
+
+func flattenSyntheticLocations(syntheticLocations *[]syntheticLocation) []interface{} {
+	if syntheticLocations != nil {
+		sls := make([]interface{}, len(*syntheticLocations), len(*syntheticLocations))
+
+		for i, syntheticLocation := range *syntheticLocations {
+			sl := make(map[string]interface{})
+
+			sl["entity_id"] = syntheticLocation.EntityID
+			sl["name"] = syntheticLocation.Name
+			sl["type"] = syntheticLocation.Type
+			sl["cloud_platform"] = syntheticLocation.CloudPlatform
+			sl["stage"] = syntheticLocation.Stage
+			sl["status"] = syntheticLocation.Status
+			sl["ips"] = syntheticLocation.Ips
+			sls[i] = sl
+		}
+
+		return sls
+	}
+
+	return make([]interface{}, 0)
+}
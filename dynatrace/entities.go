@@ -0,0 +1,196 @@
+package dynatrace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// entityDefaultFields are always requested from /entities, in addition to the fields of the configuration.
+var entityDefaultFields = []string{"+tags", "+managementZones"}
+
+// entity is a monitored entity as returned by the Environment v2 API /entities.
+type entity struct {
+	EntityID        string                 `json:"entityId"`
+	Type            string                 `json:"type"`
+	DisplayName     string                 `json:"displayName"`
+	Tags            []entityTag            `json:"tags"`
+	ManagementZones []entityManagementZone `json:"managementZones"`
+	Properties      map[string]interface{} `json:"properties"`
+}
+
+type entityManagementZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// entityList is a page of /entities.
+type entityList struct {
+	TotalCount  int      `json:"totalCount"`
+	NextPageKey string   `json:"nextPageKey"`
+	Entities    []entity `json:"entities"`
+}
+
+// listEntities returns all entities matching the query, following nextPageKey until the last page.
+func listEntities(ctx context.Context, client *restClient, query url.Values) ([]entity, error) {
+	entities := []entity{}
+
+	for {
+		var page entityList
+		if err := client.get(ctx, "/entities?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, page.Entities...)
+
+		if page.NextPageKey == "" {
+			return entities, nil
+		}
+
+		// The next page key contains all other parameters of the query
+		query = url.Values{"nextPageKey": {page.NextPageKey}}
+	}
+}
+
+// entityQuery builds the query of /entities for an entity selector, the timeframe and the additional fields.
+func entityQuery(entitySelector string, from string, to string, fields []string) url.Values {
+	query := url.Values{
+		"entitySelector": {entitySelector},
+		"fields":         {strings.Join(append(entityDefaultFields, fields...), ",")},
+	}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+
+	return query
+}
+
+func entitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"entity_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"display_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"context": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"key": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"management_zones": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"properties": &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The properties of the entity. Values which aren't strings are JSON encoded.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func flattenEntity(e *entity) map[string]interface{} {
+	fe := make(map[string]interface{})
+
+	fe["entity_id"] = e.EntityID
+	fe["display_name"] = e.DisplayName
+	fe["type"] = e.Type
+	fe["tags"] = flattenEntityTagsWithContext(&e.Tags)
+	fe["management_zones"] = flattenEntityManagementZones(&e.ManagementZones)
+	fe["properties"] = flattenEntityProperties(e.Properties)
+
+	return fe
+}
+
+func flattenEntityTagsWithContext(tags *[]entityTag) []interface{} {
+	ets := make([]interface{}, len(*tags), len(*tags))
+
+	for i, tag := range *tags {
+		et := make(map[string]interface{})
+
+		et["context"] = tag.Context
+		et["key"] = tag.Key
+		et["value"] = tag.Value
+		ets[i] = et
+	}
+
+	return ets
+}
+
+func flattenEntityManagementZones(managementZones *[]entityManagementZone) []interface{} {
+	mzs := make([]interface{}, len(*managementZones), len(*managementZones))
+
+	for i, managementZone := range *managementZones {
+		mz := make(map[string]interface{})
+
+		mz["id"] = managementZone.ID
+		mz["name"] = managementZone.Name
+		mzs[i] = mz
+	}
+
+	return mzs
+}
+
+func flattenEntityProperties(properties map[string]interface{}) map[string]interface{} {
+	ps := make(map[string]interface{})
+
+	for name, property := range properties {
+		if s, ok := property.(string); ok {
+			ps[name] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(property)
+		if err != nil {
+			ps[name] = fmt.Sprint(property)
+			continue
+		}
+		ps[name] = string(encoded)
+	}
+
+	return ps
+}
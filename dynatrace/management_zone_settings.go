@@ -0,0 +1,269 @@
+package dynatrace
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// managementZoneSettingsV2 manages dynatrace_management_zones through the settings schema builtin:management-zones,
+// which supersedes /managementZones of the Config v1 API.
+var managementZoneSettingsV2 = &legacySettingsResource{
+	SchemaID:           "builtin:management-zones",
+	NameAttribute:      "name",
+	LegacyPath:         "/managementZones",
+	LegacyNameProperty: "name",
+	LegacyIDAttribute:  "legacy_id",
+	Expand:             expandManagementZoneSettingsV2Value,
+	Flatten:            flattenManagementZoneSettingsV2Value,
+}
+
+// managementZonePropagationFlags maps the propagation types of the Config v1 API to the flags of the attribute rules.
+var managementZonePropagationFlags = map[string]string{
+	"HOST_TO_PROCESS_GROUP_INSTANCE":       "hostToPGPropagation",
+	"PROCESS_GROUP_TO_HOST":                "pgToHostPropagation",
+	"PROCESS_GROUP_TO_SERVICE":             "pgToServicePropagation",
+	"SERVICE_TO_HOST_LIKE":                 "serviceToHostPropagation",
+	"SERVICE_TO_PROCESS_GROUP_LIKE":        "serviceToPGPropagation",
+	"AZURE_TO_PG":                          "azureToPGPropagation",
+	"AZURE_TO_SERVICE":                     "azureToServicePropagation",
+	"CUSTOM_DEVICE_GROUP_TO_CUSTOM_DEVICE": "customDeviceGroupToCustomDevicePropagation",
+}
+
+func expandManagementZoneSettingsV2Value(d *schema.ResourceData) (map[string]interface{}, error) {
+	rules, err := expandManagementZoneSettingsV2Rules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"name":  d.Get("name").(string),
+		"rules": rules,
+	}, nil
+}
+
+func expandManagementZoneSettingsV2Rules(rules []interface{}) ([]interface{}, error) {
+	mrs := []interface{}{}
+
+	for _, rule := range rules {
+		m := rule.(map[string]interface{})
+
+		conditions, err := expandManagementZoneSettingsV2Conditions(m["condition"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		attributeRule := map[string]interface{}{
+			"entityType": m["type"].(string),
+			"conditions": conditions,
+		}
+		// Only the flags which are set are sent, the others default to false and are hidden for most entity types
+		for _, propagationType := range expandPropagationTypes(m["propagation_types"].(*schema.Set).List()) {
+			flag, ok := managementZonePropagationFlags[propagationType]
+			if !ok {
+				return nil, fmt.Errorf("the propagation type %s isn't supported by Settings 2.0", propagationType)
+			}
+			attributeRule[flag] = true
+		}
+
+		mrs = append(mrs, map[string]interface{}{
+			"enabled":       m["enabled"].(bool),
+			"type":          "ME",
+			"attributeRule": attributeRule,
+		})
+	}
+
+	return mrs, nil
+}
+
+// expandManagementZoneSettingsV2Conditions converts the conditions of the Config v1 API, whose comparison value is a map,
+// into Settings 2.0 conditions, which have one property per kind of value. Negated operators become NOT_ operators.
+func expandManagementZoneSettingsV2Conditions(conditions []interface{}) ([]interface{}, error) {
+	mcs := []interface{}{}
+
+	for _, condition := range conditions {
+		m := condition.(map[string]interface{})
+		key := expandConditionKey(m["key"].([]interface{}))
+
+		ci := m["comparison_info"].([]interface{})
+		if len(ci) == 0 || ci[0] == nil {
+			return nil, fmt.Errorf("the %s condition has no comparison_info", key.Attribute)
+		}
+		comparisonInfo := ci[0].(map[string]interface{})
+
+		value := map[string]string{}
+		for k, v := range comparisonInfo["value"].(map[string]interface{}) {
+			value[k] = v.(string)
+		}
+
+		comparisonType := comparisonInfo["type"].(string)
+		operator := comparisonInfo["operator"].(string)
+		mc := map[string]interface{}{
+			"key": key.Attribute,
+		}
+
+		switch {
+		case operator == "EXISTS":
+		case comparisonType == "TAG":
+			mc["tag"] = formatSettingsTag(value["context"], value["key"], value["value"])
+			if operator == "EQUALS" && value["value"] == "" {
+				operator = "TAG_KEY_EQUALS"
+			}
+		case comparisonType == "INTEGER":
+			integerValue, err := strconv.Atoi(value["value"])
+			if err != nil {
+				return nil, fmt.Errorf("the value of the %s condition must be an integer: %s", key.Attribute, err.Error())
+			}
+			mc["integerValue"] = integerValue
+		case comparisonType == "ENTITY_ID":
+			mc["entityId"] = value["value"]
+		case comparisonType == "STRING" || comparisonType == "IP_ADDRESS":
+			mc["stringValue"] = value["value"]
+			mc["caseSensitive"] = value["case_sensitive"] == "true"
+		default:
+			mc["enumValue"] = value["value"]
+		}
+
+		if comparisonInfo["negate"].(bool) {
+			operator = "NOT_" + operator
+		}
+		mc["operator"] = operator
+
+		mcs = append(mcs, mc)
+	}
+
+	return mcs, nil
+}
+
+func flattenManagementZoneSettingsV2Value(d *schema.ResourceData, value map[string]interface{}) error {
+	// Settings 2.0 conditions don't have the comparison and key types, they are kept from the state where possible
+	previous := d.Get("rule").([]interface{})
+
+	rules, err := flattenManagementZoneSettingsV2RulesData(settingsSlice(value, "rules"), previous)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return err
+	}
+
+	d.Set("name", settingsString(value, "name"))
+
+	return nil
+}
+
+func flattenManagementZoneSettingsV2RulesData(rules []interface{}, previous []interface{}) ([]interface{}, error) {
+	mrs := make([]interface{}, 0, len(rules))
+
+	for i, rule := range rules {
+		r, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ruleType := settingsString(r, "type"); ruleType != "ME" {
+			return nil, fmt.Errorf("management zone rules of type %s aren't supported", ruleType)
+		}
+
+		attributeRule := settingsMap(r, "attributeRule")
+
+		propagationTypes := []string{}
+		for propagationType, flag := range managementZonePropagationFlags {
+			if settingsBool(attributeRule, flag) {
+				propagationTypes = append(propagationTypes, propagationType)
+			}
+		}
+		sort.Strings(propagationTypes)
+
+		var previousConditions []interface{}
+		if i < len(previous) && previous[i] != nil {
+			previousConditions, _ = previous[i].(map[string]interface{})["condition"].([]interface{})
+		}
+
+		mr := make(map[string]interface{})
+
+		mr["type"] = settingsString(attributeRule, "entityType")
+		mr["enabled"] = settingsBool(r, "enabled")
+		mr["propagation_types"] = propagationTypes
+		mr["condition"] = flattenManagementZoneSettingsV2ConditionsData(settingsSlice(attributeRule, "conditions"), previousConditions)
+		mrs = append(mrs, mr)
+	}
+
+	return mrs, nil
+}
+
+func flattenManagementZoneSettingsV2ConditionsData(conditions []interface{}, previous []interface{}) []interface{} {
+	mcs := make([]interface{}, 0, len(conditions))
+
+	for i, condition := range conditions {
+		c, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		operator := settingsString(c, "operator")
+		negate := strings.HasPrefix(operator, "NOT_")
+		operator = strings.TrimPrefix(operator, "NOT_")
+
+		comparisonType := "STRING"
+		value := map[string]interface{}{}
+
+		switch {
+		case c["tag"] != nil:
+			comparisonType = "TAG"
+			context, key, tagValue := parseSettingsTag(settingsString(c, "tag"))
+			value["context"] = context
+			value["key"] = key
+			if tagValue != "" {
+				value["value"] = tagValue
+			}
+			if operator == "TAG_KEY_EQUALS" {
+				operator = "EQUALS"
+			}
+		case c["integerValue"] != nil:
+			comparisonType = "INTEGER"
+			value["value"] = strconv.Itoa(settingsInt(c, "integerValue"))
+		case c["entityId"] != nil:
+			comparisonType = "ENTITY_ID"
+			value["value"] = settingsString(c, "entityId")
+		case c["enumValue"] != nil:
+			value["value"] = settingsString(c, "enumValue")
+		case c["stringValue"] != nil:
+			value["value"] = settingsString(c, "stringValue")
+			if settingsBool(c, "caseSensitive") {
+				value["case_sensitive"] = "true"
+			}
+		}
+
+		keyType := ""
+		if i < len(previous) && previous[i] != nil {
+			p := previous[i].(map[string]interface{})
+			if k, ok := p["key"].([]interface{}); ok && len(k) > 0 && k[0] != nil {
+				keyType, _ = k[0].(map[string]interface{})["type"].(string)
+			}
+			if ci, ok := p["comparison_info"].([]interface{}); ok && len(ci) > 0 && ci[0] != nil {
+				if previousType, _ := ci[0].(map[string]interface{})["type"].(string); previousType != "" && (c["enumValue"] != nil || comparisonType == "STRING") {
+					comparisonType = previousType
+				}
+			}
+		}
+
+		mc := make(map[string]interface{})
+
+		mc["key"] = []interface{}{map[string]interface{}{
+			"attribute": settingsString(c, "key"),
+			"type":      keyType,
+		}}
+		mc["comparison_info"] = []interface{}{map[string]interface{}{
+			"operator": operator,
+			"value":    value,
+			"negate":   negate,
+			"type":     comparisonType,
+		}}
+		mcs = append(mcs, mc)
+	}
+
+	return mcs
+}
@@ -0,0 +1,120 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// oneAgentAutoUpdate is the OneAgent auto-update configuration shared by hosts, host groups and the environment.
+type oneAgentAutoUpdate struct {
+	Setting          string                `json:"setting"`
+	Version          *string               `json:"version"`
+	UpdateWindows    oneAgentUpdateWindows `json:"updateWindows"`
+	EffectiveSetting string                `json:"effectiveSetting,omitempty"`
+	EffectiveVersion *string               `json:"effectiveVersion,omitempty"`
+}
+
+type oneAgentUpdateWindows struct {
+	Windows []oneAgentUpdateWindow `json:"windows"`
+}
+
+type oneAgentUpdateWindow struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+func oneAgentAutoUpdateSettingSchema(settings []string, defaultSetting string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      defaultSetting,
+		Description:  "The auto-update setting of OneAgent. INHERITED uses the setting of the parent, i.e. the host group or the environment.",
+		ValidateFunc: validation.StringInSlice(settings, false),
+	}
+}
+
+func oneAgentTargetVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The version OneAgent is updated to, e.g. 1.203.0.20200908-220956. If not set, OneAgent is updated to the latest version. Can only be set if auto-update is ENABLED.",
+	}
+}
+
+func oneAgentUpdateWindowsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The IDs of the maintenance windows in which OneAgent updates are applied. If not set, updates are applied as soon as they are available.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// validateOneAgentAutoUpdate rejects a target_version without ENABLED auto-update at plan time, since the API ignores it.
+func validateOneAgentAutoUpdate(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("auto_update_setting") || !d.NewValueKnown("target_version") {
+		return nil
+	}
+
+	if setting := d.Get("auto_update_setting").(string); setting != "ENABLED" && d.Get("target_version").(string) != "" {
+		return fmt.Errorf("target_version can only be set if auto_update_setting is ENABLED, not %s", setting)
+	}
+
+	return nil
+}
+
+func expandOneAgentAutoUpdate(d *schema.ResourceData) oneAgentAutoUpdate {
+	autoUpdate := oneAgentAutoUpdate{
+		Setting: d.Get("auto_update_setting").(string),
+		UpdateWindows: oneAgentUpdateWindows{
+			Windows: []oneAgentUpdateWindow{},
+		},
+	}
+
+	if version := d.Get("target_version").(string); version != "" && autoUpdate.Setting == "ENABLED" {
+		autoUpdate.Version = &version
+	}
+
+	for _, id := range expandStringList(d.Get("update_windows").(*schema.Set).List()) {
+		autoUpdate.UpdateWindows.Windows = append(autoUpdate.UpdateWindows.Windows, oneAgentUpdateWindow{ID: id})
+	}
+
+	return autoUpdate
+}
+
+func flattenOneAgentUpdateWindows(updateWindows *oneAgentUpdateWindows) []interface{} {
+	ids := make([]interface{}, 0, len(updateWindows.Windows))
+	for _, window := range updateWindows.Windows {
+		ids = append(ids, window.ID)
+	}
+
+	return ids
+}
+
+func setOneAgentAutoUpdate(d *schema.ResourceData, autoUpdate *oneAgentAutoUpdate) error {
+	d.Set("auto_update_setting", autoUpdate.Setting)
+
+	if autoUpdate.Version != nil {
+		d.Set("target_version", *autoUpdate.Version)
+	} else {
+		d.Set("target_version", "")
+	}
+
+	return d.Set("update_windows", flattenOneAgentUpdateWindows(&autoUpdate.UpdateWindows))
+}
+
+// setOneAgentEffectiveAutoUpdate sets the resolved auto-update of hosts and host groups, which can inherit their setting.
+func setOneAgentEffectiveAutoUpdate(d *schema.ResourceData, autoUpdate *oneAgentAutoUpdate) {
+	d.Set("effective_setting", autoUpdate.EffectiveSetting)
+
+	if autoUpdate.EffectiveVersion != nil {
+		d.Set("effective_version", *autoUpdate.EffectiveVersion)
+	} else {
+		d.Set("effective_version", "")
+	}
+}
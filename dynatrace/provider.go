@@ -25,13 +25,59 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"DYNATRACE_API_TOKEN", "DT_API_TOKEN"}, nil),
 			},
+			"settings_v2": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DYNATRACE_SETTINGS_V2", false),
+				Description: "Manage alerting profiles and management zones through Settings 2.0 instead of the Config v1 API.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"dynatrace_alerting_profiles": resourceDynatraceAlertingProfile(),
-			"dynatrace_management_zones":  resourceDynatraceManagementZones(),
+			"dynatrace_alerting_profiles":                 resourceDynatraceAlertingProfile(),
+			"dynatrace_management_zones":                  resourceDynatraceManagementZones(),
+			"dynatrace_application_detection_rule":        resourceDynatraceApplicationDetectionRule(),
+			"dynatrace_application_detection_rules_order": resourceDynatraceApplicationDetectionRulesOrder(),
+			"dynatrace_mobile_application":                resourceDynatraceMobileApplication(),
+			"dynatrace_mobile_application_property":       resourceDynatraceMobileApplicationProperty(),
+			"dynatrace_http_monitor":                      resourceDynatraceHTTPMonitor(),
+			"dynatrace_browser_monitor":                   resourceDynatraceBrowserMonitor(),
+			"dynatrace_synthetic_location":                resourceDynatraceSyntheticLocation(),
+			"dynatrace_credentials":                       resourceDynatraceCredentials(),
+			"dynatrace_service_anomalies":                 resourceDynatraceServiceAnomalies(),
+			"dynatrace_application_anomalies":             resourceDynatraceApplicationAnomalies(),
+			"dynatrace_host_anomalies":                    resourceDynatraceHostAnomalies(),
+			"dynatrace_database_anomalies":                resourceDynatraceDatabaseAnomalies(),
+			"dynatrace_disk_anomalies":                    resourceDynatraceDiskAnomalies(),
+			"dynatrace_metric_event":                      resourceDynatraceMetricEvent(),
+			"dynatrace_process_group_anomalies":           resourceDynatraceProcessGroupAnomalies(),
+			"dynatrace_vmware_anomalies":                  resourceDynatraceVMwareAnomalies(),
+			"dynatrace_aws_anomalies":                     resourceDynatraceAWSAnomalies(),
+			"dynatrace_naming_rule":                       resourceDynatraceNamingRule(),
+			"dynatrace_host_monitoring":                   resourceDynatraceHostMonitoring(),
+			"dynatrace_host_group_autoupdate":             resourceDynatraceHostGroupAutoUpdate(),
+			"dynatrace_oneagent_autoupdate":               resourceDynatraceOneAgentAutoUpdate(),
+			"dynatrace_technology_monitoring":             resourceDynatraceTechnologyMonitoring(),
+			"dynatrace_process_group_deep_monitoring":     resourceDynatraceProcessGroupDeepMonitoring(),
+			"dynatrace_aws_credentials":                   resourceDynatraceAWSCredentials(),
+			"dynatrace_azure_credentials":                 resourceDynatraceAzureCredentials(),
+			"dynatrace_kubernetes_credentials":            resourceDynatraceKubernetesCredentials(),
+			"dynatrace_cloudfoundry_credentials":          resourceDynatraceCloudFoundryCredentials(),
+			"dynatrace_frequent_issue_detection":          resourceDynatraceFrequentIssueDetection(),
+			"dynatrace_data_privacy":                      resourceDynatraceDataPrivacy(),
+			"dynatrace_slo":                               resourceDynatraceSLO(),
+			"dynatrace_entity_tags":                       resourceDynatraceEntityTags(),
+			"dynatrace_api_token":                         resourceDynatraceAPIToken(),
+			"dynatrace_network_zone":                      resourceDynatraceNetworkZone(),
+			"dynatrace_network_zones":                     resourceDynatraceNetworkZones(),
+			"dynatrace_settings_object":                   resourceDynatraceSettingsObject(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"dynatrace_alerting_profiles": dataSourceDynatraceAlertingProfiles(),
+			"dynatrace_alerting_profiles":   dataSourceDynatraceAlertingProfiles(),
+			"dynatrace_synthetic_locations": dataSourceDynatraceSyntheticLocations(),
+			"dynatrace_entities":            dataSourceDynatraceEntities(),
+			"dynatrace_entity":              dataSourceDynatraceEntity(),
+			"dynatrace_metric":              dataSourceDynatraceMetric(),
+			"dynatrace_metric_query":        dataSourceDynatraceMetricQuery(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -39,13 +85,21 @@ func Provider() *schema.Provider {
 
 //ProviderConfiguration contains the initialized API clients to communicate with the Datadog API
 type ProviderConfiguration struct {
-	DynatraceConfigClientV1 *dynatraceConfigV1.APIClient
-	AuthConfigV1            context.Context
+	DynatraceConfigClientV1     *dynatraceConfigV1.APIClient
+	AuthConfigV1                context.Context
+	DynatraceConfigRestClientV1 *restClient
+	DynatraceEnvRestClientV1    *restClient
+	DynatraceEnvRestClientV2    *restClient
+	SettingsV2                  bool
+
+	tokenScopes     *apiTokenScopes
+	settingsSchemas *settingsSchemaCache
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	dtEnvURL := d.Get("dt_env_url").(string)
 	apiToken := d.Get("dt_api_token").(string)
+	settingsV2 := d.Get("settings_v2").(bool)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -65,9 +119,24 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	dynatraceConfigClientV1 := dynatraceConfigV1.NewAPIClient(configV1)
 
+	// Config V1 endpoints which are missing in the official client are called directly
+	dynatraceConfigRestClientV1 := newRestClient(configV1.BasePath, apiToken)
+
+	// Initialize the Dynatrace Environment V1 API client, e.g. for synthetic monitors
+	dynatraceEnvRestClientV1 := newRestClient(dtEnvURL+"/api/v1", apiToken)
+
+	// Initialize the Dynatrace Environment V2 API client, e.g. for service-level objectives
+	dynatraceEnvRestClientV2 := newRestClient(dtEnvURL+"/api/v2", apiToken)
+
 	return &ProviderConfiguration{
-		DynatraceConfigClientV1: dynatraceConfigClientV1,
-		AuthConfigV1:            authConfigV1,
+		DynatraceConfigClientV1:     dynatraceConfigClientV1,
+		AuthConfigV1:                authConfigV1,
+		DynatraceConfigRestClientV1: dynatraceConfigRestClientV1,
+		DynatraceEnvRestClientV1:    dynatraceEnvRestClientV1,
+		DynatraceEnvRestClientV2:    dynatraceEnvRestClientV2,
+		SettingsV2:                  settingsV2,
+		tokenScopes:                 &apiTokenScopes{},
+		settingsSchemas:             &settingsSchemaCache{},
 	}, diags
 
 }
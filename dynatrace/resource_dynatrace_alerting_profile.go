@@ -11,7 +11,7 @@ import (
 )
 
 func resourceDynatraceAlertingProfile() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceDynatraceAlertingProfileCreate,
 		ReadContext:   resourceDynatraceAlertingProfileRead,
 		UpdateContext: resourceDynatraceAlertingProfileUpdate,
@@ -197,11 +197,27 @@ func resourceDynatraceAlertingProfile() *schema.Resource {
 			},
 		},
 	}
+
+	// Version 1 identifies the resource by the ID of the settings object if the provider is configured with settings_v2
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resource.CoreConfigSchema().ImpliedType(),
+			Upgrade: alertingProfileSettingsV2.upgradeState,
+		},
+	}
+
+	return resource
 }
 
 func resourceDynatraceAlertingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return alertingProfileSettingsV2.create(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -238,6 +254,10 @@ func resourceDynatraceAlertingProfileCreate(ctx context.Context, d *schema.Resou
 
 func resourceDynatraceAlertingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return alertingProfileSettingsV2.read(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -273,6 +293,10 @@ func resourceDynatraceAlertingProfileRead(ctx context.Context, d *schema.Resourc
 
 func resourceDynatraceAlertingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return alertingProfileSettingsV2.update(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -310,6 +334,10 @@ func resourceDynatraceAlertingProfileUpdate(ctx context.Context, d *schema.Resou
 
 func resourceDynatraceAlertingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return alertingProfileSettingsV2.delete(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
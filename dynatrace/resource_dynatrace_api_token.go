@@ -0,0 +1,262 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiToken is an API token as used by the Environment v2 API /apiTokens.
+type apiToken struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Enabled             *bool    `json:"enabled,omitempty"`
+	PersonalAccessToken bool     `json:"personalAccessToken"`
+	Owner               string   `json:"owner,omitempty"`
+	CreationDate        string   `json:"creationDate,omitempty"`
+	ExpirationDate      string   `json:"expirationDate,omitempty"`
+	Scopes              []string `json:"scopes"`
+}
+
+// apiTokenCreated is the response of POST /apiTokens, the only response which contains the secret token.
+type apiTokenCreated struct {
+	ID             string `json:"id"`
+	Token          string `json:"token"`
+	ExpirationDate string `json:"expirationDate"`
+}
+
+func resourceDynatraceAPIToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceAPITokenCreate,
+		ReadContext:   resourceDynatraceAPITokenRead,
+		UpdateContext: resourceDynatraceAPITokenUpdate,
+		DeleteContext: resourceDynatraceAPITokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the API token.",
+			},
+			"scopes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The scopes of the API token, e.g. ReadConfig or entities.read. Changing the scopes creates a new token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The expiration date of the API token, either as ISO 8601 timestamp or relative to the creation, e.g. now+30d. If not set, the token never expires.",
+			},
+			"personal_access_token": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "The token is a personal access token (true) or an API token (false).",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The API token is enabled (true) or disabled (false).",
+			},
+			"keepers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values which create a new token when changed, e.g. a rotation date.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret API token. It is only known after the creation of the token, not after an import.",
+			},
+			"owner": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The owner of the API token.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the API token.",
+			},
+			"expires_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resolved expiration date of the API token.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceAPITokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "apiTokens.read", "apiTokens.write"); diags.HasError() {
+		return diags
+	}
+
+	token := expandAPIToken(d)
+	token.Enabled = nil
+
+	var created apiTokenCreated
+	err := dynatraceEnvRestClientV2.post(ctx, "/apiTokens", token, &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace API token",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.ID)
+	d.Set("token", created.Token)
+
+	// New tokens are always enabled, a disabled token is disabled right after the creation
+	if !d.Get("enabled").(bool) {
+		return resourceDynatraceAPITokenUpdate(ctx, d, m)
+	}
+
+	return resourceDynatraceAPITokenRead(ctx, d, m)
+}
+
+func resourceDynatraceAPITokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "apiTokens.read"); diags.HasError() {
+		return diags
+	}
+
+	tokenID := d.Id()
+
+	var token apiToken
+	err := dynatraceEnvRestClientV2.get(ctx, "/apiTokens/"+url.PathEscape(tokenID), &token)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace API token",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The configured expiration date may be relative, the resolved date is exposed as expires_at
+	d.Set("name", token.Name)
+	d.Set("scopes", token.Scopes)
+	d.Set("personal_access_token", token.PersonalAccessToken)
+	d.Set("owner", token.Owner)
+	d.Set("creation_date", token.CreationDate)
+	d.Set("expires_at", token.ExpirationDate)
+
+	if token.Enabled != nil {
+		d.Set("enabled", *token.Enabled)
+	}
+
+	return diags
+}
+
+func resourceDynatraceAPITokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "apiTokens.read", "apiTokens.write"); diags.HasError() {
+		return diags
+	}
+
+	tokenID := d.Id()
+
+	// Only the name and the enabled flag can be changed, all other changes create a new token
+	if d.HasChanges("name", "enabled") || d.IsNewResource() {
+
+		enabled := d.Get("enabled").(bool)
+		update := struct {
+			Name    string `json:"name"`
+			Enabled bool   `json:"enabled"`
+		}{
+			Name:    d.Get("name").(string),
+			Enabled: enabled,
+		}
+
+		err := dynatraceEnvRestClientV2.put(ctx, "/apiTokens/"+url.PathEscape(tokenID), update, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace API token",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceAPITokenRead(ctx, d, m)
+}
+
+func resourceDynatraceAPITokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "apiTokens.write"); diags.HasError() {
+		return diags
+	}
+
+	tokenID := d.Id()
+
+	// Deleting the token revokes it
+	err := dynatraceEnvRestClientV2.delete(ctx, "/apiTokens/"+url.PathEscape(tokenID))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace API token",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandAPIToken(d *schema.ResourceData) apiToken {
+	enabled := d.Get("enabled").(bool)
+
+	return apiToken{
+		Name:                d.Get("name").(string),
+		Enabled:             &enabled,
+		PersonalAccessToken: d.Get("personal_access_token").(bool),
+		ExpirationDate:      d.Get("expiration_date").(string),
+		Scopes:              expandStringList(d.Get("scopes").(*schema.Set).List()),
+	}
+}
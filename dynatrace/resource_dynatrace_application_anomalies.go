@@ -0,0 +1,250 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// applicationAnomaliesID is the fixed ID of the application anomaly detection singleton.
+const applicationAnomaliesID = "application_anomalies"
+
+// applicationAnomalyDetection is the request body of /anomalyDetection/applications.
+type applicationAnomalyDetection struct {
+	ResponseTimeDegradation responseTimeDegradationDetection              `json:"responseTimeDegradation"`
+	TrafficDrop             dynatraceConfigV1.TrafficDropDetectionConfig  `json:"trafficDrop"`
+	TrafficSpike            dynatraceConfigV1.TrafficSpikeDetectionConfig `json:"trafficSpike"`
+	FailureRateIncrease     failureRateIncreaseDetection                  `json:"failureRateIncrease"`
+}
+
+// defaultApplicationAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultApplicationAnomalyDetection = applicationAnomalyDetection{
+	ResponseTimeDegradation: defaultResponseTimeDegradationDetection,
+	TrafficDrop:             dynatraceConfigV1.TrafficDropDetectionConfig{Enabled: true, TrafficDropPercent: 50},
+	TrafficSpike:            dynatraceConfigV1.TrafficSpikeDetectionConfig{Enabled: true, TrafficSpikePercent: 200},
+	FailureRateIncrease:     defaultFailureRateIncreaseDetection,
+}
+
+func resourceDynatraceApplicationAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceApplicationAnomaliesCreate,
+		ReadContext:   resourceDynatraceApplicationAnomaliesRead,
+		UpdateContext: resourceDynatraceApplicationAnomaliesUpdate,
+		DeleteContext: resourceDynatraceApplicationAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"response_time_degradation": responseTimeDegradationSchema(),
+			"failure_rate_increase":     failureRateIncreaseSchema(),
+			"traffic_drop": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The configuration of traffic drops detection. Disabled if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "The detection is enabled (true) or disabled (false).",
+						},
+						"traffic_drop_percent": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Alert if the observed traffic is less than X % of the expected value.",
+						},
+					},
+				},
+			},
+			"traffic_spike": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The configuration of traffic spikes detection. Disabled if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "The detection is enabled (true) or disabled (false).",
+						},
+						"traffic_spike_percent": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Alert if the observed traffic is more than X % of the expected value.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceApplicationAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, applicationAnomaliesID, func() diag.Diagnostics {
+		return updateApplicationAnomalyDetection(m, expandApplicationAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceApplicationAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceApplicationAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	applicationAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionApplicationsApi.GetConfiguration(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	if err := d.Set("response_time_degradation", flattenResponseTimeDegradation(&applicationAnomalies.ResponseTimeDegradation)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("failure_rate_increase", flattenFailureRateIncrease(&applicationAnomalies.FailureRateIncrease)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("traffic_drop", flattenTrafficDrop(&applicationAnomalies.TrafficDrop, d.Get("traffic_drop").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("traffic_spike", flattenTrafficSpike(&applicationAnomalies.TrafficSpike, d.Get("traffic_spike").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceApplicationAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("response_time_degradation", "failure_rate_increase", "traffic_drop", "traffic_spike") {
+		if diags := updateApplicationAnomalyDetection(m, expandApplicationAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceApplicationAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceApplicationAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateApplicationAnomalyDetection(m, defaultApplicationAnomalyDetection)
+	})
+}
+
+func updateApplicationAnomalyDetection(m interface{}, applicationAnomalies applicationAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	applicationAnomaliesBody := dynatraceConfigV1.UpdateConfigurationOpts{
+		ApplicationAnomalyDetectionConfig: optional.NewInterface(applicationAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionApplicationsApi.UpdateConfiguration(authConfigV1, &applicationAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandApplicationAnomalyDetection(d *schema.ResourceData) applicationAnomalyDetection {
+	return applicationAnomalyDetection{
+		ResponseTimeDegradation: expandResponseTimeDegradation(d.Get("response_time_degradation").([]interface{})),
+		FailureRateIncrease:     expandFailureRateIncrease(d.Get("failure_rate_increase").([]interface{})),
+		TrafficDrop:             expandTrafficDrop(d.Get("traffic_drop").([]interface{})),
+		TrafficSpike:            expandTrafficSpike(d.Get("traffic_spike").([]interface{})),
+	}
+}
+
+func expandTrafficDrop(trafficDrop []interface{}) dynatraceConfigV1.TrafficDropDetectionConfig {
+	if len(trafficDrop) == 0 || trafficDrop[0] == nil {
+		return dynatraceConfigV1.TrafficDropDetectionConfig{Enabled: false}
+	}
+
+	m := trafficDrop[0].(map[string]interface{})
+
+	return dynatraceConfigV1.TrafficDropDetectionConfig{
+		Enabled:            m["enabled"].(bool),
+		TrafficDropPercent: int32(m["traffic_drop_percent"].(int)),
+	}
+}
+
+func expandTrafficSpike(trafficSpike []interface{}) dynatraceConfigV1.TrafficSpikeDetectionConfig {
+	if len(trafficSpike) == 0 || trafficSpike[0] == nil {
+		return dynatraceConfigV1.TrafficSpikeDetectionConfig{Enabled: false}
+	}
+
+	m := trafficSpike[0].(map[string]interface{})
+
+	return dynatraceConfigV1.TrafficSpikeDetectionConfig{
+		Enabled:             m["enabled"].(bool),
+		TrafficSpikePercent: int32(m["traffic_spike_percent"].(int)),
+	}
+}
+
+// flattenTrafficDrop keeps a disabled block of the previous state, see flattenLoadDrop.
+func flattenTrafficDrop(trafficDrop *dynatraceConfigV1.TrafficDropDetectionConfig, previous []interface{}) []interface{} {
+	if trafficDrop == nil || !trafficDrop.Enabled {
+		if len(previous) == 0 || previous[0] == nil {
+			return make([]interface{}, 0)
+		}
+
+		p := previous[0].(map[string]interface{})
+		p["enabled"] = false
+
+		return []interface{}{p}
+	}
+
+	t := make(map[string]interface{})
+
+	t["enabled"] = trafficDrop.Enabled
+	t["traffic_drop_percent"] = trafficDrop.TrafficDropPercent
+
+	return []interface{}{t}
+}
+
+// flattenTrafficSpike keeps a disabled block of the previous state, see flattenLoadDrop.
+func flattenTrafficSpike(trafficSpike *dynatraceConfigV1.TrafficSpikeDetectionConfig, previous []interface{}) []interface{} {
+	if trafficSpike == nil || !trafficSpike.Enabled {
+		if len(previous) == 0 || previous[0] == nil {
+			return make([]interface{}, 0)
+		}
+
+		p := previous[0].(map[string]interface{})
+		p["enabled"] = false
+
+		return []interface{}{p}
+	}
+
+	t := make(map[string]interface{})
+
+	t["enabled"] = trafficSpike.Enabled
+	t["traffic_spike_percent"] = trafficSpike.TrafficSpikePercent
+
+	return []interface{}{t}
+}
@@ -0,0 +1,233 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+func resourceDynatraceApplicationDetectionRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceApplicationDetectionRuleCreate,
+		ReadContext:   resourceDynatraceApplicationDetectionRuleRead,
+		UpdateContext: resourceDynatraceApplicationDetectionRuleUpdate,
+		DeleteContext: resourceDynatraceApplicationDetectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_identifier": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Dynatrace entity ID of the application, for example APPLICATION-4A3B43.",
+			},
+			"position": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "APPEND",
+				ForceNew:     true,
+				Description:  "The position of the new rule in the rules list, either APPEND or PREPEND. Only used on creation, use dynatrace_application_detection_rules_order to manage the global order.",
+				ValidateFunc: validation.StringInSlice([]string{"APPEND", "PREPEND"}, false),
+			},
+			"order": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The order of the rule in the rules list. The rules are evaluated from top to bottom. The first matching rule applies.",
+			},
+			"filter_config": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The condition of the application detection rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value to look for.",
+						},
+						"application_match_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The operator of the matching, e.g. BEGINS_WITH, CONTAINS, ENDS_WITH, EQUALS or MATCHES.",
+						},
+						"application_match_target": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Where to look for the pattern value, either DOMAIN or URL.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceApplicationDetectionRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	adr := dynatraceConfigV1.ApplicationDetectionRuleConfig{
+		ApplicationIdentifier: d.Get("application_identifier").(string),
+		FilterConfig:          expandApplicationFilter(d.Get("filter_config").([]interface{})),
+	}
+
+	adrBody := dynatraceConfigV1.PostConfigOpts{
+		Position:                       optional.NewString(d.Get("position").(string)),
+		ApplicationDetectionRuleConfig: optional.NewInterface(adr),
+	}
+
+	applicationDetectionRule, _, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.PostConfig(authConfigV1, &adrBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId(applicationDetectionRule.Id)
+
+	resourceDynatraceApplicationDetectionRuleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceApplicationDetectionRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	applicationDetectionRuleID := d.Id()
+
+	applicationDetectionRule, _, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.GetConfig(authConfigV1, applicationDetectionRuleID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	filterConfig := flattenApplicationFilter(&applicationDetectionRule.FilterConfig)
+	if err := d.Set("filter_config", filterConfig); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("application_identifier", &applicationDetectionRule.ApplicationIdentifier)
+	d.Set("order", &applicationDetectionRule.Order)
+
+	return diags
+}
+
+func resourceDynatraceApplicationDetectionRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	applicationDetectionRuleID := d.Id()
+
+	if d.HasChange("application_identifier") || d.HasChange("filter_config") {
+
+		adr := dynatraceConfigV1.ApplicationDetectionRuleConfig{
+			Id:                    applicationDetectionRuleID,
+			ApplicationIdentifier: d.Get("application_identifier").(string),
+			FilterConfig:          expandApplicationFilter(d.Get("filter_config").([]interface{})),
+		}
+
+		adrBody := dynatraceConfigV1.PutConfigOpts{
+			ApplicationDetectionRuleConfig: optional.NewInterface(adr),
+		}
+
+		_, _, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.PutConfig(authConfigV1, applicationDetectionRuleID, &adrBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceApplicationDetectionRuleRead(ctx, d, m)
+}
+
+func resourceDynatraceApplicationDetectionRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	applicationDetectionRuleID := d.Id()
+
+	_, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.Delete(authConfigV1, applicationDetectionRuleID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandApplicationFilter(filterConfig []interface{}) dynatraceConfigV1.ApplicationFilter {
+	if len(filterConfig) == 0 || filterConfig[0] == nil {
+		return dynatraceConfigV1.ApplicationFilter{}
+	}
+
+	m := filterConfig[0].(map[string]interface{})
+
+	af := dynatraceConfigV1.ApplicationFilter{}
+
+	if pattern, ok := m["pattern"]; ok {
+		af.Pattern = pattern.(string)
+	}
+
+	if matchType, ok := m["application_match_type"]; ok {
+		af.ApplicationMatchType = matchType.(string)
+	}
+
+	if matchTarget, ok := m["application_match_target"]; ok {
+		af.ApplicationMatchTarget = matchTarget.(string)
+	}
+
+	return af
+
+}
+
+func flattenApplicationFilter(applicationFilter *dynatraceConfigV1.ApplicationFilter) []interface{} {
+	if applicationFilter == nil {
+		return []interface{}{applicationFilter}
+	}
+
+	f := make(map[string]interface{})
+
+	f["pattern"] = applicationFilter.Pattern
+	f["application_match_type"] = applicationFilter.ApplicationMatchType
+	f["application_match_target"] = applicationFilter.ApplicationMatchTarget
+
+	return []interface{}{f}
+}
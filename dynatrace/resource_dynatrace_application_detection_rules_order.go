@@ -0,0 +1,154 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// applicationDetectionRulesOrderID is the fixed ID of the order resource, there is only one global rules list per environment.
+const applicationDetectionRulesOrderID = "application_detection_rules_order"
+
+func resourceDynatraceApplicationDetectionRulesOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceApplicationDetectionRulesOrderCreate,
+		ReadContext:   resourceDynatraceApplicationDetectionRulesOrderRead,
+		UpdateContext: resourceDynatraceApplicationDetectionRulesOrderUpdate,
+		DeleteContext: resourceDynatraceApplicationDetectionRulesOrderDelete,
+
+		Schema: map[string]*schema.Schema{
+			"rule_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IDs of the application detection rules in the order they should be evaluated. The listed rules are moved to the top of the rules list, rules not listed keep their relative order below them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceApplicationDetectionRulesOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := applyApplicationDetectionRulesOrder(d, m); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(applicationDetectionRulesOrderID)
+
+	return resourceDynatraceApplicationDetectionRulesOrderRead(ctx, d, m)
+}
+
+func resourceDynatraceApplicationDetectionRulesOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	rules, _, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.ListConfigurations1(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	managed := make(map[string]bool)
+	for _, id := range d.Get("rule_ids").([]interface{}) {
+		managed[id.(string)] = true
+	}
+
+	// Only the relative order of the rules managed by this resource is tracked,
+	// rules created outside of it must not cause a diff.
+	ruleIDs := []interface{}{}
+	for _, rule := range rules.Values {
+		if managed[rule.Id] {
+			ruleIDs = append(ruleIDs, rule.Id)
+		}
+	}
+
+	if err := d.Set("rule_ids", ruleIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceApplicationDetectionRulesOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("rule_ids") {
+		if diags := applyApplicationDetectionRulesOrder(d, m); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceApplicationDetectionRulesOrderRead(ctx, d, m)
+}
+
+func resourceDynatraceApplicationDetectionRulesOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The rules keep their current order, there is nothing to restore.
+	d.SetId("")
+
+	return diags
+}
+
+func applyApplicationDetectionRulesOrder(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	rules, _, err := dynatraceConfigClientV1.ApplicationDetectionRulesApi.ListConfigurations1(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	orderBody := dynatraceConfigV1.ReorderListOpts{
+		StubList: optional.NewInterface(expandApplicationDetectionRulesOrder(d.Get("rule_ids").([]interface{}), rules.Values)),
+	}
+
+	_, err = dynatraceConfigClientV1.ApplicationDetectionRulesApi.ReorderList(authConfigV1, &orderBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandApplicationDetectionRulesOrder(ruleIDs []interface{}, currentRules []dynatraceConfigV1.EntityShortRepresentation) dynatraceConfigV1.StubList {
+	ordered := make([]dynatraceConfigV1.EntityShortRepresentation, 0, len(currentRules))
+	listed := make(map[string]bool)
+
+	for _, id := range ruleIDs {
+		listed[id.(string)] = true
+		ordered = append(ordered, dynatraceConfigV1.EntityShortRepresentation{Id: id.(string)})
+	}
+
+	for _, rule := range currentRules {
+		if !listed[rule.Id] {
+			ordered = append(ordered, dynatraceConfigV1.EntityShortRepresentation{Id: rule.Id})
+		}
+	}
+
+	return dynatraceConfigV1.StubList{Values: ordered}
+}
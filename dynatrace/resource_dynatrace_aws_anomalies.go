@@ -0,0 +1,332 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// awsAnomaliesID is the fixed ID of the AWS anomaly detection singleton.
+const awsAnomaliesID = "aws_anomalies"
+
+// awsAnomalyDetection is the request body of /anomalyDetection/aws.
+type awsAnomalyDetection struct {
+	RdsHighCpuDetection                customThresholdDetection `json:"rdsHighCpuDetection"`
+	RdsHighWriteReadLatencyDetection   customThresholdDetection `json:"rdsHighWriteReadLatencyDetection"`
+	RdsLowStorageDetection             customThresholdDetection `json:"rdsLowStorageDetection"`
+	RdsHighMemoryDetection             customThresholdDetection `json:"rdsHighMemoryDetection"`
+	ElbHighConnectionErrorsDetection   customThresholdDetection `json:"elbHighConnectionErrorsDetection"`
+	RdsRestartsSequenceDetection       customThresholdDetection `json:"rdsRestartsSequenceDetection"`
+	LambdaHighErrorRateDetection       customThresholdDetection `json:"lambdaHighErrorRateDetection"`
+	Ec2CandidateCpuSaturationDetection customThresholdDetection `json:"ec2CandidateCpuSaturationDetection"`
+}
+
+// defaultAWSAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultAWSAnomalyDetection = awsAnomalyDetection{
+	RdsHighCpuDetection:                customThresholdDetection{Enabled: true},
+	RdsHighWriteReadLatencyDetection:   customThresholdDetection{Enabled: true},
+	RdsLowStorageDetection:             customThresholdDetection{Enabled: true},
+	RdsHighMemoryDetection:             customThresholdDetection{Enabled: true},
+	ElbHighConnectionErrorsDetection:   customThresholdDetection{Enabled: true},
+	RdsRestartsSequenceDetection:       customThresholdDetection{Enabled: true},
+	LambdaHighErrorRateDetection:       customThresholdDetection{Enabled: true},
+	Ec2CandidateCpuSaturationDetection: customThresholdDetection{Enabled: true},
+}
+
+func resourceDynatraceAWSAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceAWSAnomaliesCreate,
+		ReadContext:   resourceDynatraceAWSAnomaliesRead,
+		UpdateContext: resourceDynatraceAWSAnomaliesUpdate,
+		DeleteContext: resourceDynatraceAWSAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rds_high_cpu_detection": customThresholdDetectionSchema("Configuration of high CPU saturation detection on RDS.", map[string]*schema.Schema{
+				"cpu_usage_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if CPU usage is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+			"rds_high_write_read_latency_detection": customThresholdDetectionSchema("Configuration of high read/write latency detection on RDS.", map[string]*schema.Schema{
+				"write_read_latency": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the read/write latency is higher than X milliseconds in 3 out of 5 samples.",
+				},
+			}),
+			"rds_low_storage_detection": customThresholdDetectionSchema("Configuration of low storage space detection on RDS.", map[string]*schema.Schema{
+				"free_storage_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the free storage space divided by allocated storage is lower than X % in 3 out of 5 samples.",
+				},
+			}),
+			"rds_high_memory_detection": customThresholdDetectionSchema("Configuration of high memory usage detection on RDS.", map[string]*schema.Schema{
+				"free_memory": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "Alert if the freeable memory is lower than X megabytes in 3 out of 5 samples.",
+				},
+				"swap_usage": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "Alert if the swap usage is higher than X gigabytes in 3 out of 5 samples.",
+				},
+			}),
+			"elb_high_connection_errors_detection": customThresholdDetectionSchema("Configuration of high number of backend connection errors detection on ELB.", map[string]*schema.Schema{
+				"connection_errors_per_minute": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of backend connection errors is higher than X per minute in 3 out of 5 samples.",
+				},
+			}),
+			"rds_restarts_sequence_detection": customThresholdDetectionSchema("Configuration of restarts sequence detection on RDS.", map[string]*schema.Schema{
+				"restarts_per_minute": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of restarts is X per minute or higher in 3 out of 20 samples.",
+				},
+			}),
+			"lambda_high_error_rate_detection": customThresholdDetectionSchema("Configuration of high error rate detection on Lambda.", map[string]*schema.Schema{
+				"failed_invocations_rate": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the failed invocations rate is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+			"ec2_candidate_cpu_saturation_detection": customThresholdDetectionSchema("Configuration of high CPU saturation detection on EC2 monitoring candidates.", map[string]*schema.Schema{
+				"cpu_usage_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if CPU usage is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+		},
+	}
+}
+
+func resourceDynatraceAWSAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, awsAnomaliesID, func() diag.Diagnostics {
+		return updateAWSAnomalyDetection(m, expandAWSAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceAWSAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceAWSAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	awsAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionAWSApi.GetAwsAnomalyDetectionConfig(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	for key, detection := range flattenAWSAnomalyDetection(&awsAnomalies) {
+		if err := d.Set(key, detection); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDynatraceAWSAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("rds_high_cpu_detection", "rds_high_write_read_latency_detection", "rds_low_storage_detection", "rds_high_memory_detection", "elb_high_connection_errors_detection", "rds_restarts_sequence_detection", "lambda_high_error_rate_detection", "ec2_candidate_cpu_saturation_detection") {
+		if diags := updateAWSAnomalyDetection(m, expandAWSAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceAWSAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceAWSAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateAWSAnomalyDetection(m, defaultAWSAnomalyDetection)
+	})
+}
+
+func updateAWSAnomalyDetection(m interface{}, awsAnomalies awsAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	awsAnomaliesBody := dynatraceConfigV1.UpdateAwsAnomalyDetectionConfigOpts{
+		AwsAnomalyDetectionConfig: optional.NewInterface(awsAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionAWSApi.UpdateAwsAnomalyDetectionConfig(authConfigV1, &awsAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandAWSAnomalyDetection(d *schema.ResourceData) awsAnomalyDetection {
+	var awsAnomalies awsAnomalyDetection
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("rds_high_cpu_detection").([]interface{})); t != nil {
+		awsAnomalies.RdsHighCpuDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.RdsHighCpuThresholds{
+			CpuUsagePercentage: int32(t["cpu_usage_percentage"].(int)),
+		}}
+	} else {
+		awsAnomalies.RdsHighCpuDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("rds_high_write_read_latency_detection").([]interface{})); t != nil {
+		awsAnomalies.RdsHighWriteReadLatencyDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.RdsHighLatencyThresholds{
+			WriteReadLatency: int32(t["write_read_latency"].(int)),
+		}}
+	} else {
+		awsAnomalies.RdsHighWriteReadLatencyDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("rds_low_storage_detection").([]interface{})); t != nil {
+		awsAnomalies.RdsLowStorageDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.RdsLowStorageThresholds{
+			FreeStoragePercentage: int32(t["free_storage_percentage"].(int)),
+		}}
+	} else {
+		awsAnomalies.RdsLowStorageDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("rds_high_memory_detection").([]interface{})); t != nil {
+		awsAnomalies.RdsHighMemoryDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.RdsHighMemoryThresholds{
+			FreeMemory: float32(t["free_memory"].(float64)),
+			SwapUsage:  float32(t["swap_usage"].(float64)),
+		}}
+	} else {
+		awsAnomalies.RdsHighMemoryDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("elb_high_connection_errors_detection").([]interface{})); t != nil {
+		awsAnomalies.ElbHighConnectionErrorsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.ElbHighConnectionErrorsThresholds{
+			ConnectionErrorsPerMinute: int32(t["connection_errors_per_minute"].(int)),
+		}}
+	} else {
+		awsAnomalies.ElbHighConnectionErrorsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("rds_restarts_sequence_detection").([]interface{})); t != nil {
+		awsAnomalies.RdsRestartsSequenceDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.RdsRestartsThresholds{
+			RestartsPerMinute: int32(t["restarts_per_minute"].(int)),
+		}}
+	} else {
+		awsAnomalies.RdsRestartsSequenceDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("lambda_high_error_rate_detection").([]interface{})); t != nil {
+		awsAnomalies.LambdaHighErrorRateDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.LambdaHighErrorRateThresholds{
+			FailedInvocationsRate: int32(t["failed_invocations_rate"].(int)),
+		}}
+	} else {
+		awsAnomalies.LambdaHighErrorRateDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("ec2_candidate_cpu_saturation_detection").([]interface{})); t != nil {
+		awsAnomalies.Ec2CandidateCpuSaturationDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.Ec2CandidateCpuSaturationThresholds{
+			CpuUsagePercentage: int32(t["cpu_usage_percentage"].(int)),
+		}}
+	} else {
+		awsAnomalies.Ec2CandidateCpuSaturationDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	return awsAnomalies
+}
+
+func flattenAWSAnomalyDetection(awsAnomalies *dynatraceConfigV1.AwsAnomalyDetectionConfig) map[string][]interface{} {
+	a := make(map[string][]interface{})
+
+	var rdsHighCpuDetection map[string]interface{}
+	if (dynatraceConfigV1.RdsHighCpuThresholds{}) != awsAnomalies.RdsHighCpuDetection.CustomThresholds {
+		rdsHighCpuDetection = map[string]interface{}{
+			"cpu_usage_percentage": awsAnomalies.RdsHighCpuDetection.CustomThresholds.CpuUsagePercentage,
+		}
+	}
+	a["rds_high_cpu_detection"] = flattenCustomThresholdDetection(awsAnomalies.RdsHighCpuDetection.Enabled, rdsHighCpuDetection)
+
+	var rdsHighWriteReadLatencyDetection map[string]interface{}
+	if (dynatraceConfigV1.RdsHighLatencyThresholds{}) != awsAnomalies.RdsHighWriteReadLatencyDetection.CustomThresholds {
+		rdsHighWriteReadLatencyDetection = map[string]interface{}{
+			"write_read_latency": awsAnomalies.RdsHighWriteReadLatencyDetection.CustomThresholds.WriteReadLatency,
+		}
+	}
+	a["rds_high_write_read_latency_detection"] = flattenCustomThresholdDetection(awsAnomalies.RdsHighWriteReadLatencyDetection.Enabled, rdsHighWriteReadLatencyDetection)
+
+	var rdsLowStorageDetection map[string]interface{}
+	if (dynatraceConfigV1.RdsLowStorageThresholds{}) != awsAnomalies.RdsLowStorageDetection.CustomThresholds {
+		rdsLowStorageDetection = map[string]interface{}{
+			"free_storage_percentage": awsAnomalies.RdsLowStorageDetection.CustomThresholds.FreeStoragePercentage,
+		}
+	}
+	a["rds_low_storage_detection"] = flattenCustomThresholdDetection(awsAnomalies.RdsLowStorageDetection.Enabled, rdsLowStorageDetection)
+
+	var rdsHighMemoryDetection map[string]interface{}
+	if (dynatraceConfigV1.RdsHighMemoryThresholds{}) != awsAnomalies.RdsHighMemoryDetection.CustomThresholds {
+		rdsHighMemoryDetection = map[string]interface{}{
+			"free_memory": float64(awsAnomalies.RdsHighMemoryDetection.CustomThresholds.FreeMemory),
+			"swap_usage":  float64(awsAnomalies.RdsHighMemoryDetection.CustomThresholds.SwapUsage),
+		}
+	}
+	a["rds_high_memory_detection"] = flattenCustomThresholdDetection(awsAnomalies.RdsHighMemoryDetection.Enabled, rdsHighMemoryDetection)
+
+	var elbHighConnectionErrorsDetection map[string]interface{}
+	if (dynatraceConfigV1.ElbHighConnectionErrorsThresholds{}) != awsAnomalies.ElbHighConnectionErrorsDetection.CustomThresholds {
+		elbHighConnectionErrorsDetection = map[string]interface{}{
+			"connection_errors_per_minute": awsAnomalies.ElbHighConnectionErrorsDetection.CustomThresholds.ConnectionErrorsPerMinute,
+		}
+	}
+	a["elb_high_connection_errors_detection"] = flattenCustomThresholdDetection(awsAnomalies.ElbHighConnectionErrorsDetection.Enabled, elbHighConnectionErrorsDetection)
+
+	var rdsRestartsSequenceDetection map[string]interface{}
+	if (dynatraceConfigV1.RdsRestartsThresholds{}) != awsAnomalies.RdsRestartsSequenceDetection.CustomThresholds {
+		rdsRestartsSequenceDetection = map[string]interface{}{
+			"restarts_per_minute": awsAnomalies.RdsRestartsSequenceDetection.CustomThresholds.RestartsPerMinute,
+		}
+	}
+	a["rds_restarts_sequence_detection"] = flattenCustomThresholdDetection(awsAnomalies.RdsRestartsSequenceDetection.Enabled, rdsRestartsSequenceDetection)
+
+	var lambdaHighErrorRateDetection map[string]interface{}
+	if (dynatraceConfigV1.LambdaHighErrorRateThresholds{}) != awsAnomalies.LambdaHighErrorRateDetection.CustomThresholds {
+		lambdaHighErrorRateDetection = map[string]interface{}{
+			"failed_invocations_rate": awsAnomalies.LambdaHighErrorRateDetection.CustomThresholds.FailedInvocationsRate,
+		}
+	}
+	a["lambda_high_error_rate_detection"] = flattenCustomThresholdDetection(awsAnomalies.LambdaHighErrorRateDetection.Enabled, lambdaHighErrorRateDetection)
+
+	var ec2CandidateCpuSaturationDetection map[string]interface{}
+	if (dynatraceConfigV1.Ec2CandidateCpuSaturationThresholds{}) != awsAnomalies.Ec2CandidateCpuSaturationDetection.CustomThresholds {
+		ec2CandidateCpuSaturationDetection = map[string]interface{}{
+			"cpu_usage_percentage": awsAnomalies.Ec2CandidateCpuSaturationDetection.CustomThresholds.CpuUsagePercentage,
+		}
+	}
+	a["ec2_candidate_cpu_saturation_detection"] = flattenCustomThresholdDetection(awsAnomalies.Ec2CandidateCpuSaturationDetection.Enabled, ec2CandidateCpuSaturationDetection)
+
+	return a
+}
@@ -0,0 +1,399 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// awsCredentials is the request body of /aws/credentials. Unlike AwsCredentialsConfig of the official
+// client it only sends the authentication which applies to the authentication type.
+type awsCredentials struct {
+	Id                          string                                         `json:"id,omitempty"`
+	Label                       string                                         `json:"label"`
+	PartitionType               string                                         `json:"partitionType"`
+	AuthenticationData          awsAuthenticationData                          `json:"authenticationData"`
+	TaggedOnly                  bool                                           `json:"taggedOnly"`
+	TagsToMonitor               []cloudCredentialsTag                          `json:"tagsToMonitor"`
+	SupportingServicesToMonitor []dynatraceConfigV1.AwsSupportingServiceConfig `json:"supportingServicesToMonitor"`
+	ConnectionStatus            string                                         `json:"connectionStatus,omitempty"`
+}
+
+type awsAuthenticationData struct {
+	Type                    string                                     `json:"type"`
+	KeyBasedAuthentication  *dynatraceConfigV1.KeyBasedAuthentication  `json:"keyBasedAuthentication,omitempty"`
+	RoleBasedAuthentication *dynatraceConfigV1.RoleBasedAuthentication `json:"roleBasedAuthentication,omitempty"`
+}
+
+func resourceDynatraceAWSCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceAWSCredentialsCreate,
+		ReadContext:   resourceDynatraceAWSCredentialsRead,
+		UpdateContext: resourceDynatraceAWSCredentialsUpdate,
+		DeleteContext: resourceDynatraceAWSCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credentials.",
+			},
+			"partition_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AWS_DEFAULT",
+				Description:  "The type of the AWS partition, either AWS_DEFAULT, AWS_CN or AWS_US_GOV.",
+				ValidateFunc: validation.StringInSlice([]string{"AWS_DEFAULT", "AWS_CN", "AWS_US_GOV"}, false),
+			},
+			"role_based_authentication": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Authentication with an IAM role assumed by Dynatrace.",
+				ExactlyOneOf: []string{"role_based_authentication", "key_based_authentication"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_role": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IAM role to be used by Dynatrace to get monitoring data.",
+						},
+						"account_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Amazon account.",
+						},
+						"external_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The external ID token for setting an IAM role. If not set, the token of the environment is used.",
+						},
+					},
+				},
+			},
+			"key_based_authentication": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Authentication with an access key. Write-only, it is never read back from Dynatrace.",
+				ExactlyOneOf: []string{"role_based_authentication", "key_based_authentication"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the access key.",
+						},
+						"secret_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The secret access key.",
+						},
+					},
+				},
+			},
+			"tagged_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Monitor only resources which have specified AWS tags (true) or all resources (false).",
+			},
+			"tags_to_monitor": cloudCredentialsTagsSchema("A list of AWS tags to be monitored. Only applicable when tagged_only is true."),
+			"supporting_service": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of supporting services to be monitored.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the supporting service, e.g. sqs.",
+						},
+						"monitored_metric": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of metrics to be monitored for this service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the metric of the supporting service.",
+									},
+									"statistic": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The statistic (aggregation) to be used for the metric, e.g. AVERAGE, SUM or AVG_MIN_MAX.",
+										ValidateFunc: validation.StringInSlice([]string{"AVERAGE", "MINIMUM", "MAXIMUM", "SUM", "SAMPLE_COUNT", "AVG_MIN_MAX"}, false),
+									},
+									"dimensions": &schema.Schema{
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "A list of dimension names of the metric.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"connection_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the connection to the AWS environment.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceAWSCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	awsCredentialsBody := dynatraceConfigV1.CreateAwsCredentialsConfigOpts{
+		AwsCredentialsConfig: optional.NewInterface(expandAWSCredentials(d)),
+	}
+
+	awsCredentials, _, err := dynatraceConfigClientV1.AWSCredentialsConfigurationApi.CreateAwsCredentialsConfig(authConfigV1, &awsCredentialsBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId(awsCredentials.Id)
+
+	resourceDynatraceAWSCredentialsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceAWSCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	awsCredentialsID := d.Id()
+
+	awsCredentials, _, err := dynatraceConfigClientV1.AWSCredentialsConfigurationApi.ReadAwsCredentialsConfig(authConfigV1, awsCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.Set("label", &awsCredentials.Label)
+	d.Set("partition_type", &awsCredentials.PartitionType)
+	d.Set("tagged_only", &awsCredentials.TaggedOnly)
+	d.Set("connection_status", &awsCredentials.ConnectionStatus)
+
+	// The keys of a key based authentication are never returned, so only a change in the configuration causes a diff
+	if awsCredentials.AuthenticationData.Type == "ROLE" {
+		if err := d.Set("role_based_authentication", flattenAWSRoleBasedAuthentication(&awsCredentials.AuthenticationData.RoleBasedAuthentication)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tags := make([]cloudCredentialsTag, 0, len(awsCredentials.TagsToMonitor))
+	for _, tag := range awsCredentials.TagsToMonitor {
+		tags = append(tags, cloudCredentialsTag{Name: tag.Name, Value: tag.Value})
+	}
+
+	if err := d.Set("tags_to_monitor", flattenCloudCredentialsTags(&tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("supporting_service", flattenAWSSupportingServices(&awsCredentials.SupportingServicesToMonitor)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceAWSCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	awsCredentialsID := d.Id()
+
+	if d.HasChanges("label", "partition_type", "role_based_authentication", "key_based_authentication", "tagged_only", "tags_to_monitor", "supporting_service") {
+
+		ac := expandAWSCredentials(d)
+		ac.Id = awsCredentialsID
+
+		awsCredentialsBody := dynatraceConfigV1.CreateOrUpdateAwsCredentialsConfigOpts{
+			AwsCredentialsConfig: optional.NewInterface(ac),
+		}
+
+		_, _, err := dynatraceConfigClientV1.AWSCredentialsConfigurationApi.CreateOrUpdateAwsCredentialsConfig(authConfigV1, awsCredentialsID, &awsCredentialsBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceAWSCredentialsRead(ctx, d, m)
+}
+
+func resourceDynatraceAWSCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	awsCredentialsID := d.Id()
+
+	_, err := dynatraceConfigClientV1.AWSCredentialsConfigurationApi.DeleteAwsCredentialsConfig(authConfigV1, awsCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandAWSCredentials(d *schema.ResourceData) awsCredentials {
+	ac := awsCredentials{
+		Label:                       d.Get("label").(string),
+		PartitionType:               d.Get("partition_type").(string),
+		TaggedOnly:                  d.Get("tagged_only").(bool),
+		TagsToMonitor:               expandCloudCredentialsTags(d.Get("tags_to_monitor").([]interface{})),
+		SupportingServicesToMonitor: expandAWSSupportingServices(d.Get("supporting_service").([]interface{})),
+	}
+
+	if v := d.Get("role_based_authentication").([]interface{}); len(v) != 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		ac.AuthenticationData = awsAuthenticationData{
+			Type: "ROLE",
+			RoleBasedAuthentication: &dynatraceConfigV1.RoleBasedAuthentication{
+				IamRole:    m["iam_role"].(string),
+				AccountId:  m["account_id"].(string),
+				ExternalId: m["external_id"].(string),
+			},
+		}
+	}
+
+	if v := d.Get("key_based_authentication").([]interface{}); len(v) != 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		ac.AuthenticationData = awsAuthenticationData{
+			Type: "KEYS",
+			KeyBasedAuthentication: &dynatraceConfigV1.KeyBasedAuthentication{
+				AccessKey: m["access_key"].(string),
+				SecretKey: m["secret_key"].(string),
+			},
+		}
+	}
+
+	return ac
+}
+
+func expandAWSSupportingServices(supportingServices []interface{}) []dynatraceConfigV1.AwsSupportingServiceConfig {
+	ass := make([]dynatraceConfigV1.AwsSupportingServiceConfig, 0, len(supportingServices))
+
+	for _, supportingService := range supportingServices {
+		m := supportingService.(map[string]interface{})
+
+		as := dynatraceConfigV1.AwsSupportingServiceConfig{
+			Name:             m["name"].(string),
+			MonitoredMetrics: []dynatraceConfigV1.AwsSupportingServiceMetric{},
+		}
+
+		for _, monitoredMetric := range m["monitored_metric"].([]interface{}) {
+			mm := monitoredMetric.(map[string]interface{})
+
+			as.MonitoredMetrics = append(as.MonitoredMetrics, dynatraceConfigV1.AwsSupportingServiceMetric{
+				Name:       mm["name"].(string),
+				Statistic:  mm["statistic"].(string),
+				Dimensions: expandStringList(mm["dimensions"].([]interface{})),
+			})
+		}
+
+		ass = append(ass, as)
+	}
+
+	return ass
+}
+
+func flattenAWSRoleBasedAuthentication(roleBasedAuthentication *dynatraceConfigV1.RoleBasedAuthentication) []interface{} {
+	if roleBasedAuthentication == nil {
+		return []interface{}{roleBasedAuthentication}
+	}
+
+	r := make(map[string]interface{})
+
+	r["iam_role"] = roleBasedAuthentication.IamRole
+	r["account_id"] = roleBasedAuthentication.AccountId
+	r["external_id"] = roleBasedAuthentication.ExternalId
+
+	return []interface{}{r}
+}
+
+func flattenAWSSupportingServices(supportingServices *[]dynatraceConfigV1.AwsSupportingServiceConfig) []interface{} {
+	if supportingServices != nil {
+		ass := make([]interface{}, len(*supportingServices), len(*supportingServices))
+
+		for i, supportingService := range *supportingServices {
+			as := make(map[string]interface{})
+
+			mms := make([]interface{}, len(supportingService.MonitoredMetrics), len(supportingService.MonitoredMetrics))
+			for j, monitoredMetric := range supportingService.MonitoredMetrics {
+				mm := make(map[string]interface{})
+
+				mm["name"] = monitoredMetric.Name
+				mm["statistic"] = monitoredMetric.Statistic
+				mm["dimensions"] = monitoredMetric.Dimensions
+				mms[j] = mm
+			}
+
+			as["name"] = supportingService.Name
+			as["monitored_metric"] = mms
+			ass[i] = as
+		}
+
+		return ass
+	}
+
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,217 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// azureCredentials is the request body of /azure/credentials. Unlike AzureCredentials of the official
+// client it always sends the active flag, which allows to disable the monitoring.
+type azureCredentials struct {
+	Id                        string                `json:"id,omitempty"`
+	Label                     string                `json:"label"`
+	AppId                     string                `json:"appId"`
+	DirectoryId               string                `json:"directoryId"`
+	Key                       string                `json:"key,omitempty"`
+	Active                    bool                  `json:"active"`
+	AutoTagging               bool                  `json:"autoTagging"`
+	MonitorOnlyTaggedEntities bool                  `json:"monitorOnlyTaggedEntities"`
+	MonitorOnlyTagPairs       []cloudCredentialsTag `json:"monitorOnlyTagPairs"`
+}
+
+func resourceDynatraceAzureCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceAzureCredentialsCreate,
+		ReadContext:   resourceDynatraceAzureCredentialsRead,
+		UpdateContext: resourceDynatraceAzureCredentialsUpdate,
+		DeleteContext: resourceDynatraceAzureCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique name of the Azure credentials.",
+			},
+			"app_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Application ID (also referred to as Client ID).",
+			},
+			"directory_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Directory ID (also referred to as Tenant ID).",
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret key associated with the Application ID. Write-only, it is never read back from Dynatrace.",
+			},
+			"active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitoring is enabled (true) or disabled (false).",
+			},
+			"auto_tagging": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The automatic capture of Azure tags is on (true) or off (false).",
+			},
+			"monitor_only_tagged_entities": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Monitor only resources that have specified Azure tags (true) or all resources (false).",
+			},
+			"monitor_only_tag_pairs": cloudCredentialsTagsSchema("A list of Azure tags to be monitored. A resource tagged with any of the tags is monitored. Only applicable when monitor_only_tagged_entities is true."),
+		},
+	}
+}
+
+func resourceDynatraceAzureCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	azureCredentialsBody := dynatraceConfigV1.CreateConfiguration2Opts{
+		AzureCredentials: optional.NewInterface(expandAzureCredentials(d)),
+	}
+
+	azureCredentials, _, err := dynatraceConfigClientV1.AzureCredentialsConfigurationApi.CreateConfiguration2(authConfigV1, &azureCredentialsBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId(azureCredentials.Id)
+
+	resourceDynatraceAzureCredentialsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceAzureCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	azureCredentialsID := d.Id()
+
+	azureCredentials, _, err := dynatraceConfigClientV1.AzureCredentialsConfigurationApi.GetConfiguration7(authConfigV1, azureCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	// The key is never returned, so only a change in the configuration causes a diff
+	d.Set("label", &azureCredentials.Label)
+	d.Set("app_id", &azureCredentials.AppId)
+	d.Set("directory_id", &azureCredentials.DirectoryId)
+	d.Set("active", &azureCredentials.Active)
+	d.Set("auto_tagging", &azureCredentials.AutoTagging)
+	d.Set("monitor_only_tagged_entities", &azureCredentials.MonitorOnlyTaggedEntities)
+
+	tags := make([]cloudCredentialsTag, 0, len(azureCredentials.MonitorOnlyTagPairs))
+	for _, tag := range azureCredentials.MonitorOnlyTagPairs {
+		tags = append(tags, cloudCredentialsTag{Name: tag.Name, Value: tag.Value})
+	}
+
+	if err := d.Set("monitor_only_tag_pairs", flattenCloudCredentialsTags(&tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceAzureCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	azureCredentialsID := d.Id()
+
+	if d.HasChanges("label", "app_id", "directory_id", "key", "active", "auto_tagging", "monitor_only_tagged_entities", "monitor_only_tag_pairs") {
+
+		ac := expandAzureCredentials(d)
+		ac.Id = azureCredentialsID
+
+		azureCredentialsBody := dynatraceConfigV1.UpdateConfiguration4Opts{
+			AzureCredentials: optional.NewInterface(ac),
+		}
+
+		_, _, err := dynatraceConfigClientV1.AzureCredentialsConfigurationApi.UpdateConfiguration4(authConfigV1, azureCredentialsID, &azureCredentialsBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceAzureCredentialsRead(ctx, d, m)
+}
+
+func resourceDynatraceAzureCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	azureCredentialsID := d.Id()
+
+	_, err := dynatraceConfigClientV1.AzureCredentialsConfigurationApi.DeleteConfiguration3(authConfigV1, azureCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandAzureCredentials(d *schema.ResourceData) azureCredentials {
+	return azureCredentials{
+		Label:                     d.Get("label").(string),
+		AppId:                     d.Get("app_id").(string),
+		DirectoryId:               d.Get("directory_id").(string),
+		Key:                       d.Get("key").(string),
+		Active:                    d.Get("active").(bool),
+		AutoTagging:               d.Get("auto_tagging").(bool),
+		MonitorOnlyTaggedEntities: d.Get("monitor_only_tagged_entities").(bool),
+		MonitorOnlyTagPairs:       expandCloudCredentialsTags(d.Get("monitor_only_tag_pairs").([]interface{})),
+	}
+}
@@ -0,0 +1,1180 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// browserMonitor is a synthetic monitor of type BROWSER as used by /synthetic/monitors.
+type browserMonitor struct {
+	EntityID              string                       `json:"entityId,omitempty"`
+	Name                  string                       `json:"name"`
+	FrequencyMin          int                          `json:"frequencyMin"`
+	Enabled               bool                         `json:"enabled"`
+	Type                  string                       `json:"type"`
+	CreatedFrom           string                       `json:"createdFrom,omitempty"`
+	Script                browserMonitorScript         `json:"script"`
+	Locations             []string                     `json:"locations"`
+	AnomalyDetection      *syntheticAnomalyDetection   `json:"anomalyDetection,omitempty"`
+	Tags                  []syntheticMonitorTag        `json:"tags"`
+	ManuallyAssignedApps  []string                     `json:"manuallyAssignedApps"`
+	KeyPerformanceMetrics *browserKeyPerformanceMetric `json:"keyPerformanceMetrics,omitempty"`
+}
+
+type browserKeyPerformanceMetric struct {
+	LoadActionKpm string `json:"loadActionKpm"`
+	XhrActionKpm  string `json:"xhrActionKpm"`
+}
+
+type browserMonitorScript struct {
+	Type          string                     `json:"type"`
+	Version       string                     `json:"version"`
+	Configuration browserScriptConfiguration `json:"configuration"`
+	Events        []browserEvent             `json:"events"`
+}
+
+type browserScriptConfiguration struct {
+	UserAgent      string                 `json:"userAgent,omitempty"`
+	Device         *browserDevice         `json:"device,omitempty"`
+	Bandwidth      *browserBandwidth      `json:"bandwidth,omitempty"`
+	RequestHeaders *browserRequestHeaders `json:"requestHeaders,omitempty"`
+	BypassCSP      bool                   `json:"bypassCSP"`
+}
+
+type browserDevice struct {
+	DeviceName   string  `json:"deviceName,omitempty"`
+	Orientation  string  `json:"orientation,omitempty"`
+	Mobile       bool    `json:"mobile,omitempty"`
+	TouchEnabled bool    `json:"touchEnabled,omitempty"`
+	Width        int     `json:"width,omitempty"`
+	Height       int     `json:"height,omitempty"`
+	ScaleFactor  float64 `json:"scaleFactor,omitempty"`
+}
+
+type browserBandwidth struct {
+	NetworkType string `json:"networkType,omitempty"`
+	Latency     int    `json:"latency,omitempty"`
+	Download    int    `json:"download,omitempty"`
+	Upload      int    `json:"upload,omitempty"`
+}
+
+type browserRequestHeaders struct {
+	AddHeaders []httpMonitorHeader `json:"addHeaders"`
+}
+
+type browserEvent struct {
+	Type              string                      `json:"type"`
+	Description       string                      `json:"description,omitempty"`
+	URL               string                      `json:"url,omitempty"`
+	Authentication    *browserAuthentication      `json:"authentication,omitempty"`
+	Target            *browserTarget              `json:"target,omitempty"`
+	Button            *int                        `json:"button,omitempty"`
+	TextValue         string                      `json:"textValue,omitempty"`
+	Masked            bool                        `json:"masked,omitempty"`
+	SimulateBlurEvent bool                        `json:"simulateBlurEvent,omitempty"`
+	Credential        *browserKeystrokeCredential `json:"credential,omitempty"`
+	JavaScript        string                      `json:"javaScript,omitempty"`
+	Selections        []browserSelection          `json:"selections,omitempty"`
+	Cookies           []browserCookie             `json:"cookies,omitempty"`
+	Wait              *browserWait                `json:"wait,omitempty"`
+	Validate          []browserValidation         `json:"validate,omitempty"`
+}
+
+type browserAuthentication struct {
+	Type       string              `json:"type"`
+	Credential browserCredentialID `json:"credential"`
+}
+
+type browserKeystrokeCredential struct {
+	Field      string              `json:"field"`
+	Credential browserCredentialID `json:"credential"`
+}
+
+type browserCredentialID struct {
+	ID string `json:"id"`
+}
+
+type browserTarget struct {
+	Window   string           `json:"window,omitempty"`
+	Locators []browserLocator `json:"locators,omitempty"`
+}
+
+type browserLocator struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type browserSelection struct {
+	Index int    `json:"index"`
+	Value string `json:"value"`
+}
+
+type browserCookie struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Domain string `json:"domain"`
+	Path   string `json:"path,omitempty"`
+}
+
+type browserWait struct {
+	WaitFor               string             `json:"waitFor"`
+	Milliseconds          int                `json:"milliseconds,omitempty"`
+	TimeoutInMilliseconds int                `json:"timeoutInMilliseconds,omitempty"`
+	Validation            *browserValidation `json:"validation,omitempty"`
+}
+
+type browserValidation struct {
+	Type        string         `json:"type"`
+	Match       string         `json:"match,omitempty"`
+	IsRegex     bool           `json:"isRegex"`
+	FailIfFound bool           `json:"failIfFound"`
+	Target      *browserTarget `json:"target,omitempty"`
+}
+
+func resourceDynatraceBrowserMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceBrowserMonitorCreate,
+		ReadContext:   resourceDynatraceBrowserMonitorRead,
+		UpdateContext: resourceDynatraceBrowserMonitorUpdate,
+		DeleteContext: resourceDynatraceBrowserMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the monitor.",
+			},
+			"frequency": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The frequency of the monitor, in minutes.",
+				ValidateFunc: validation.IntInSlice([]int{0, 5, 10, 15, 30, 60}),
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitor is enabled (true) or disabled (false).",
+			},
+			"locations": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "A list of locations from which the monitor is executed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"manually_assigned_apps": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of manually assigned applications.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags":              syntheticMonitorTagsSchema(),
+			"anomaly_detection": syntheticAnomalyDetectionSchema(),
+			"key_performance_metrics": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The key performance metrics of the monitor.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_action_kpm": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Defines the key performance metric for load actions, e.g. VISUALLY_COMPLETE, SPEED_INDEX, USER_ACTION_DURATION or DOM_INTERACTIVE.",
+						},
+						"xhr_action_kpm": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Defines the key performance metric for XHR actions, e.g. VISUALLY_COMPLETE, USER_ACTION_DURATION or RESPONSE_END.",
+						},
+					},
+				},
+			},
+			"user_agent": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The user agent of the monitor.",
+			},
+			"bypass_csp": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Bypass the content security policy of the monitored page.",
+			},
+			"header": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The HTTP headers added to every request of the monitor.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the header.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value of the header.",
+						},
+					},
+				},
+			},
+			"device": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The emulated device of the monitor. Either a device name or a custom device.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of a preconfigured device, e.g. Apple iPhone 8.",
+						},
+						"orientation": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The orientation of the device, either portrait or landscape.",
+							ValidateFunc: validation.StringInSlice([]string{"portrait", "landscape"}, false),
+						},
+						"mobile": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "The custom device is a mobile device (true) or not (false).",
+						},
+						"touch_enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "The custom device supports touch (true) or not (false).",
+						},
+						"width": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The screen width of the custom device in pixels.",
+						},
+						"height": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The screen height of the custom device in pixels.",
+						},
+						"scale_factor": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The pixel ratio of the custom device.",
+						},
+					},
+				},
+			},
+			"bandwidth": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The emulated network bandwidth of the monitor. Either a network type or custom values.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the preconfigured network, e.g. GPRS, EDGE, DSL or WIFI.",
+						},
+						"latency": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The latency of the custom network in milliseconds.",
+						},
+						"download": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The download speed of the custom network in kilobytes per second.",
+						},
+						"upload": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The upload speed of the custom network in kilobytes per second.",
+						},
+					},
+				},
+			},
+			"url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The URL of a single-URL monitor. Conflicts with event.",
+				ExactlyOneOf: []string{"url", "event"},
+			},
+			"event": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "The events of a clickpath monitor, in order of execution. Conflicts with url.",
+				ExactlyOneOf: []string{"url", "event"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The type of the event, either navigate, click, keystrokes, javascript, selectOption or cookie.",
+							ValidateFunc: validation.StringInSlice([]string{"navigate", "click", "keystrokes", "javascript", "selectOption", "cookie"}, false),
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "A short description of the event to appear in the UI. Generated by Dynatrace if not set.",
+						},
+						"url": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The URL to navigate to. Only applicable to navigate events.",
+						},
+						"authentication": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The authentication of a navigate event.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The type of the authentication, either basic, ntlm or kerberos.",
+										ValidateFunc: validation.StringInSlice([]string{"basic", "ntlm", "kerberos"}, false),
+									},
+									"credential_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the credentials in the credential vault.",
+									},
+								},
+							},
+						},
+						"target": browserTargetSchema("The target element of a click, keystrokes, javascript or selectOption event."),
+						"button": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "The mouse button of a click event, 0 for the left, 1 for the middle and 2 for the right button.",
+						},
+						"text_value": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The text to enter. Only applicable to keystrokes events.",
+						},
+						"masked": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Mask the entered text in the UI. Only applicable to keystrokes events.",
+						},
+						"simulate_blur_event": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Simulate a blur event after entering the text. Only applicable to keystrokes events.",
+						},
+						"credential": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Enter a value from the credential vault instead of text_value. Only applicable to keystrokes events.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The field of the credentials to enter, either username or password.",
+										ValidateFunc: validation.StringInSlice([]string{"username", "password"}, false),
+									},
+									"credential_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the credentials in the credential vault.",
+									},
+								},
+							},
+						},
+						"javascript": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The JavaScript code to execute. Only applicable to javascript events.",
+						},
+						"selection": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The options to select. Only applicable to selectOption events.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index": &schema.Schema{
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "The index of the option.",
+									},
+									"value": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The value of the option.",
+									},
+								},
+							},
+						},
+						"cookie": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The cookies to set. Only applicable to cookie events.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the cookie.",
+									},
+									"value": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The value of the cookie.",
+									},
+									"domain": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The domain of the cookie.",
+									},
+									"path": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The path of the cookie.",
+									},
+								},
+							},
+						},
+						"wait": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: "The wait condition of the event. Dynatrace applies a default if not set.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"wait_for": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The time to wait before the next event is triggered, either page_complete, network, next_action, time or validation.",
+										ValidateFunc: validation.StringInSlice([]string{"page_complete", "network", "next_action", "time", "validation"}, false),
+									},
+									"milliseconds": &schema.Schema{
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The time to wait in milliseconds. Only applicable when wait_for is time.",
+									},
+									"timeout_in_milliseconds": &schema.Schema{
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The maximum time to wait in milliseconds. Only applicable when wait_for is validation.",
+									},
+									"validation": browserValidationSchema("The element to wait for. Only applicable when wait_for is validation.", 1),
+								},
+							},
+						},
+						"validate": browserValidationSchema("The validation rules of the event.", 0),
+					},
+				},
+			},
+		},
+	}
+}
+
+func browserTargetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"window": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "The tab of the target.",
+				},
+				"locator": &schema.Schema{
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The locators of the target, tried in order until one matches.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": &schema.Schema{
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The type of the locator, either css or dom.",
+								ValidateFunc: validation.StringInSlice([]string{"css", "dom"}, false),
+							},
+							"value": &schema.Schema{
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The name of the element to be found.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func browserValidationSchema(description string, maxItems int) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    maxItems,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The type of the validation, either content_match or element_match.",
+					ValidateFunc: validation.StringInSlice([]string{"content_match", "element_match"}, false),
+				},
+				"match": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The content to look for.",
+				},
+				"is_regex": &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "The match is a regular expression (true) or a plain text (false).",
+				},
+				"fail_if_found": &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "The validation fails (true) or succeeds (false) if the content is found.",
+				},
+				"target": browserTargetSchema("The element to look in. Only applicable to element_match validations."),
+			},
+		},
+	}
+}
+
+func resourceDynatraceBrowserMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	monitor := expandBrowserMonitor(d)
+	monitor.CreatedFrom = "API"
+
+	var created syntheticMonitorID
+	err := dynatraceEnvRestClientV1.post(ctx, "/synthetic/monitors", monitor, &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace browser monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.EntityID)
+
+	resourceDynatraceBrowserMonitorRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceBrowserMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	var monitor browserMonitor
+	err := dynatraceEnvRestClientV1.get(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID), &monitor)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace browser monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// A single-URL monitor consists of exactly one navigate event which is exposed as url
+	if monitor.Script.Type == "availability" && len(monitor.Script.Events) > 0 {
+		d.Set("url", monitor.Script.Events[0].URL)
+		if err := d.Set("event", []interface{}{}); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		d.Set("url", "")
+		if err := d.Set("event", flattenBrowserEvents(&monitor.Script.Events)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("tags", flattenSyntheticMonitorTags(&monitor.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("anomaly_detection", flattenSyntheticAnomalyDetection(monitor.AnomalyDetection)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key_performance_metrics", flattenBrowserKeyPerformanceMetrics(monitor.KeyPerformanceMetrics)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	configuration := monitor.Script.Configuration
+
+	if err := d.Set("device", flattenBrowserDevice(configuration.Device)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("bandwidth", flattenBrowserBandwidth(configuration.Bandwidth)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	headers := []httpMonitorHeader{}
+	if configuration.RequestHeaders != nil {
+		headers = configuration.RequestHeaders.AddHeaders
+	}
+	if err := d.Set("header", flattenHTTPMonitorHeaders(&headers)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", monitor.Name)
+	d.Set("frequency", monitor.FrequencyMin)
+	d.Set("enabled", monitor.Enabled)
+	d.Set("locations", monitor.Locations)
+	d.Set("manually_assigned_apps", monitor.ManuallyAssignedApps)
+	d.Set("user_agent", configuration.UserAgent)
+	d.Set("bypass_csp", configuration.BypassCSP)
+
+	return diags
+}
+
+func resourceDynatraceBrowserMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	if d.HasChanges("name", "frequency", "enabled", "locations", "manually_assigned_apps", "tags", "anomaly_detection",
+		"key_performance_metrics", "user_agent", "bypass_csp", "header", "device", "bandwidth", "url", "event") {
+
+		monitor := expandBrowserMonitor(d)
+		monitor.EntityID = monitorID
+
+		err := dynatraceEnvRestClientV1.put(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID), monitor, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace browser monitor",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceBrowserMonitorRead(ctx, d, m)
+}
+
+func resourceDynatraceBrowserMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	err := dynatraceEnvRestClientV1.delete(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace browser monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandBrowserMonitor(d *schema.ResourceData) browserMonitor {
+	script := browserMonitorScript{
+		Type:    "clickpath",
+		Version: "1.0",
+		Configuration: browserScriptConfiguration{
+			UserAgent: d.Get("user_agent").(string),
+			Device:    expandBrowserDevice(d.Get("device").([]interface{})),
+			Bandwidth: expandBrowserBandwidth(d.Get("bandwidth").([]interface{})),
+			BypassCSP: d.Get("bypass_csp").(bool),
+		},
+		Events: expandBrowserEvents(d.Get("event").([]interface{})),
+	}
+
+	if headers := expandHTTPMonitorHeaders(d.Get("header").([]interface{})); len(headers) > 0 {
+		script.Configuration.RequestHeaders = &browserRequestHeaders{AddHeaders: headers}
+	}
+
+	if singleURL := d.Get("url").(string); singleURL != "" {
+		script.Type = "availability"
+		script.Events = []browserEvent{
+			{
+				Type: "navigate",
+				URL:  singleURL,
+				Wait: &browserWait{WaitFor: "page_complete"},
+			},
+		}
+	}
+
+	return browserMonitor{
+		Name:                  d.Get("name").(string),
+		FrequencyMin:          d.Get("frequency").(int),
+		Enabled:               d.Get("enabled").(bool),
+		Type:                  "BROWSER",
+		Script:                script,
+		Locations:             expandStringList(d.Get("locations").(*schema.Set).List()),
+		AnomalyDetection:      expandSyntheticAnomalyDetection(d.Get("anomaly_detection").([]interface{})),
+		Tags:                  expandSyntheticMonitorTags(d.Get("tags").([]interface{})),
+		ManuallyAssignedApps:  expandStringList(d.Get("manually_assigned_apps").(*schema.Set).List()),
+		KeyPerformanceMetrics: expandBrowserKeyPerformanceMetrics(d.Get("key_performance_metrics").([]interface{})),
+	}
+}
+
+func expandBrowserKeyPerformanceMetrics(kpms []interface{}) *browserKeyPerformanceMetric {
+	if len(kpms) == 0 || kpms[0] == nil {
+		return nil
+	}
+
+	m := kpms[0].(map[string]interface{})
+
+	return &browserKeyPerformanceMetric{
+		LoadActionKpm: m["load_action_kpm"].(string),
+		XhrActionKpm:  m["xhr_action_kpm"].(string),
+	}
+}
+
+func expandBrowserDevice(device []interface{}) *browserDevice {
+	if len(device) == 0 || device[0] == nil {
+		return nil
+	}
+
+	m := device[0].(map[string]interface{})
+
+	return &browserDevice{
+		DeviceName:   m["name"].(string),
+		Orientation:  m["orientation"].(string),
+		Mobile:       m["mobile"].(bool),
+		TouchEnabled: m["touch_enabled"].(bool),
+		Width:        m["width"].(int),
+		Height:       m["height"].(int),
+		ScaleFactor:  m["scale_factor"].(float64),
+	}
+}
+
+func expandBrowserBandwidth(bandwidth []interface{}) *browserBandwidth {
+	if len(bandwidth) == 0 || bandwidth[0] == nil {
+		return nil
+	}
+
+	m := bandwidth[0].(map[string]interface{})
+
+	return &browserBandwidth{
+		NetworkType: m["network_type"].(string),
+		Latency:     m["latency"].(int),
+		Download:    m["download"].(int),
+		Upload:      m["upload"].(int),
+	}
+}
+
+func expandBrowserEvents(events []interface{}) []browserEvent {
+	if len(events) < 1 {
+		return []browserEvent{}
+	}
+
+	bes := make([]browserEvent, len(events))
+
+	for i, event := range events {
+
+		m := event.(map[string]interface{})
+
+		be := browserEvent{
+			Type:        m["type"].(string),
+			Description: m["description"].(string),
+			Target:      expandBrowserTarget(m["target"].([]interface{})),
+			Wait:        expandBrowserWait(m["wait"].([]interface{})),
+			Validate:    expandBrowserValidations(m["validate"].([]interface{})),
+		}
+
+		switch be.Type {
+		case "navigate":
+			be.URL = m["url"].(string)
+			be.Authentication = expandBrowserAuthentication(m["authentication"].([]interface{}))
+		case "click":
+			button := m["button"].(int)
+			be.Button = &button
+		case "keystrokes":
+			be.TextValue = m["text_value"].(string)
+			be.Masked = m["masked"].(bool)
+			be.SimulateBlurEvent = m["simulate_blur_event"].(bool)
+			be.Credential = expandBrowserKeystrokeCredential(m["credential"].([]interface{}))
+		case "javascript":
+			be.JavaScript = m["javascript"].(string)
+		case "selectOption":
+			be.Selections = expandBrowserSelections(m["selection"].([]interface{}))
+		case "cookie":
+			be.Cookies = expandBrowserCookies(m["cookie"].([]interface{}))
+		}
+
+		bes[i] = be
+	}
+
+	return bes
+}
+
+func expandBrowserAuthentication(authentication []interface{}) *browserAuthentication {
+	if len(authentication) == 0 || authentication[0] == nil {
+		return nil
+	}
+
+	m := authentication[0].(map[string]interface{})
+
+	return &browserAuthentication{
+		Type:       m["type"].(string),
+		Credential: browserCredentialID{ID: m["credential_id"].(string)},
+	}
+}
+
+func expandBrowserKeystrokeCredential(credential []interface{}) *browserKeystrokeCredential {
+	if len(credential) == 0 || credential[0] == nil {
+		return nil
+	}
+
+	m := credential[0].(map[string]interface{})
+
+	return &browserKeystrokeCredential{
+		Field:      m["field"].(string),
+		Credential: browserCredentialID{ID: m["credential_id"].(string)},
+	}
+}
+
+func expandBrowserTarget(target []interface{}) *browserTarget {
+	if len(target) == 0 || target[0] == nil {
+		return nil
+	}
+
+	m := target[0].(map[string]interface{})
+
+	bt := &browserTarget{
+		Window: m["window"].(string),
+	}
+
+	for _, locator := range m["locator"].([]interface{}) {
+		l := locator.(map[string]interface{})
+
+		bt.Locators = append(bt.Locators, browserLocator{
+			Type:  l["type"].(string),
+			Value: l["value"].(string),
+		})
+	}
+
+	return bt
+}
+
+func expandBrowserWait(wait []interface{}) *browserWait {
+	if len(wait) == 0 || wait[0] == nil {
+		return nil
+	}
+
+	m := wait[0].(map[string]interface{})
+
+	bw := &browserWait{
+		WaitFor:               m["wait_for"].(string),
+		Milliseconds:          m["milliseconds"].(int),
+		TimeoutInMilliseconds: m["timeout_in_milliseconds"].(int),
+	}
+
+	if validations := expandBrowserValidations(m["validation"].([]interface{})); len(validations) > 0 {
+		bw.Validation = &validations[0]
+	}
+
+	return bw
+}
+
+func expandBrowserValidations(validations []interface{}) []browserValidation {
+	if len(validations) < 1 {
+		return nil
+	}
+
+	bvs := make([]browserValidation, len(validations))
+
+	for i, validation := range validations {
+
+		m := validation.(map[string]interface{})
+
+		bvs[i] = browserValidation{
+			Type:        m["type"].(string),
+			Match:       m["match"].(string),
+			IsRegex:     m["is_regex"].(bool),
+			FailIfFound: m["fail_if_found"].(bool),
+			Target:      expandBrowserTarget(m["target"].([]interface{})),
+		}
+	}
+
+	return bvs
+}
+
+func expandBrowserSelections(selections []interface{}) []browserSelection {
+	bss := make([]browserSelection, len(selections))
+
+	for i, selection := range selections {
+
+		m := selection.(map[string]interface{})
+
+		bss[i] = browserSelection{
+			Index: m["index"].(int),
+			Value: m["value"].(string),
+		}
+	}
+
+	return bss
+}
+
+func expandBrowserCookies(cookies []interface{}) []browserCookie {
+	bcs := make([]browserCookie, len(cookies))
+
+	for i, cookie := range cookies {
+
+		m := cookie.(map[string]interface{})
+
+		bcs[i] = browserCookie{
+			Name:   m["name"].(string),
+			Value:  m["value"].(string),
+			Domain: m["domain"].(string),
+			Path:   m["path"].(string),
+		}
+	}
+
+	return bcs
+}
+
+func flattenBrowserKeyPerformanceMetrics(kpms *browserKeyPerformanceMetric) []interface{} {
+	if kpms == nil {
+		return make([]interface{}, 0)
+	}
+
+	k := make(map[string]interface{})
+
+	k["load_action_kpm"] = kpms.LoadActionKpm
+	k["xhr_action_kpm"] = kpms.XhrActionKpm
+
+	return []interface{}{k}
+}
+
+func flattenBrowserDevice(device *browserDevice) []interface{} {
+	if device == nil {
+		return make([]interface{}, 0)
+	}
+
+	dv := make(map[string]interface{})
+
+	dv["name"] = device.DeviceName
+	dv["orientation"] = device.Orientation
+	dv["mobile"] = device.Mobile
+	dv["touch_enabled"] = device.TouchEnabled
+	dv["width"] = device.Width
+	dv["height"] = device.Height
+	dv["scale_factor"] = device.ScaleFactor
+
+	return []interface{}{dv}
+}
+
+func flattenBrowserBandwidth(bandwidth *browserBandwidth) []interface{} {
+	if bandwidth == nil {
+		return make([]interface{}, 0)
+	}
+
+	b := make(map[string]interface{})
+
+	b["network_type"] = bandwidth.NetworkType
+	b["latency"] = bandwidth.Latency
+	b["download"] = bandwidth.Download
+	b["upload"] = bandwidth.Upload
+
+	return []interface{}{b}
+}
+
+func flattenBrowserEvents(browserEvents *[]browserEvent) []interface{} {
+	if browserEvents != nil {
+		bes := make([]interface{}, len(*browserEvents), len(*browserEvents))
+
+		for i, browserEvent := range *browserEvents {
+			be := make(map[string]interface{})
+
+			be["type"] = browserEvent.Type
+			be["description"] = browserEvent.Description
+			be["url"] = browserEvent.URL
+			be["authentication"] = flattenBrowserAuthentication(browserEvent.Authentication)
+			be["target"] = flattenBrowserTarget(browserEvent.Target)
+			be["text_value"] = browserEvent.TextValue
+			be["masked"] = browserEvent.Masked
+			be["simulate_blur_event"] = browserEvent.SimulateBlurEvent
+			be["credential"] = flattenBrowserKeystrokeCredential(browserEvent.Credential)
+			be["javascript"] = browserEvent.JavaScript
+			be["selection"] = flattenBrowserSelections(browserEvent.Selections)
+			be["cookie"] = flattenBrowserCookies(browserEvent.Cookies)
+			be["wait"] = flattenBrowserWait(browserEvent.Wait)
+			be["validate"] = flattenBrowserValidations(browserEvent.Validate)
+
+			be["button"] = 0
+			if browserEvent.Button != nil {
+				be["button"] = *browserEvent.Button
+			}
+
+			bes[i] = be
+		}
+
+		return bes
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenBrowserAuthentication(authentication *browserAuthentication) []interface{} {
+	if authentication == nil {
+		return make([]interface{}, 0)
+	}
+
+	a := make(map[string]interface{})
+
+	a["type"] = authentication.Type
+	a["credential_id"] = authentication.Credential.ID
+
+	return []interface{}{a}
+}
+
+func flattenBrowserKeystrokeCredential(credential *browserKeystrokeCredential) []interface{} {
+	if credential == nil {
+		return make([]interface{}, 0)
+	}
+
+	c := make(map[string]interface{})
+
+	c["field"] = credential.Field
+	c["credential_id"] = credential.Credential.ID
+
+	return []interface{}{c}
+}
+
+func flattenBrowserTarget(target *browserTarget) []interface{} {
+	if target == nil {
+		return make([]interface{}, 0)
+	}
+
+	ls := make([]interface{}, len(target.Locators))
+
+	for i, locator := range target.Locators {
+		l := make(map[string]interface{})
+
+		l["type"] = locator.Type
+		l["value"] = locator.Value
+		ls[i] = l
+	}
+
+	t := make(map[string]interface{})
+
+	t["window"] = target.Window
+	t["locator"] = ls
+
+	return []interface{}{t}
+}
+
+func flattenBrowserWait(wait *browserWait) []interface{} {
+	if wait == nil {
+		return make([]interface{}, 0)
+	}
+
+	w := make(map[string]interface{})
+
+	w["wait_for"] = wait.WaitFor
+	w["milliseconds"] = wait.Milliseconds
+	w["timeout_in_milliseconds"] = wait.TimeoutInMilliseconds
+	w["validation"] = make([]interface{}, 0)
+
+	if wait.Validation != nil {
+		w["validation"] = flattenBrowserValidations([]browserValidation{*wait.Validation})
+	}
+
+	return []interface{}{w}
+}
+
+func flattenBrowserValidations(validations []browserValidation) []interface{} {
+	vs := make([]interface{}, len(validations))
+
+	for i, validation := range validations {
+		v := make(map[string]interface{})
+
+		v["type"] = validation.Type
+		v["match"] = validation.Match
+		v["is_regex"] = validation.IsRegex
+		v["fail_if_found"] = validation.FailIfFound
+		v["target"] = flattenBrowserTarget(validation.Target)
+		vs[i] = v
+	}
+
+	return vs
+}
+
+func flattenBrowserSelections(selections []browserSelection) []interface{} {
+	ss := make([]interface{}, len(selections))
+
+	for i, selection := range selections {
+		s := make(map[string]interface{})
+
+		s["index"] = selection.Index
+		s["value"] = selection.Value
+		ss[i] = s
+	}
+
+	return ss
+}
+
+func flattenBrowserCookies(cookies []browserCookie) []interface{} {
+	cs := make([]interface{}, len(cookies))
+
+	for i, cookie := range cookies {
+		c := make(map[string]interface{})
+
+		c["name"] = cookie.Name
+		c["value"] = cookie.Value
+		c["domain"] = cookie.Domain
+		c["path"] = cookie.Path
+		cs[i] = c
+	}
+
+	return cs
+}
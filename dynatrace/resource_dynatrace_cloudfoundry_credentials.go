@@ -0,0 +1,194 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudFoundryCredentials is the Cloud Foundry foundation credentials as used by /cloudFoundry/credentials.
+// The official client only accepts CloudFoundryCredentials, which never sends a disabled active flag.
+type cloudFoundryCredentials struct {
+	Id             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	ApiUrl         string `json:"apiUrl"`
+	LoginUrl       string `json:"loginUrl"`
+	Username       string `json:"username"`
+	Password       string `json:"password,omitempty"`
+	Active         bool   `json:"active"`
+	EndpointStatus string `json:"endpointStatus,omitempty"`
+}
+
+func resourceDynatraceCloudFoundryCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceCloudFoundryCredentialsCreate,
+		ReadContext:   resourceDynatraceCloudFoundryCredentialsRead,
+		UpdateContext: resourceDynatraceCloudFoundryCredentialsUpdate,
+		DeleteContext: resourceDynatraceCloudFoundryCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Cloud Foundry foundation credentials.",
+			},
+			"api_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the Cloud Foundry API.",
+			},
+			"login_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The login URL of the Cloud Foundry foundation.",
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the Cloud Foundry foundation credentials.",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the Cloud Foundry foundation credentials. Write-only, it is never read back from Dynatrace.",
+			},
+			"active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitoring is enabled (true) or disabled (false).",
+			},
+			"endpoint_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the configured endpoint, e.g. ASSIGNED or FASTCHECK_AUTH_ERROR.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceCloudFoundryCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	var created entityShortRepresentation
+	err := dynatraceConfigRestClientV1.post(ctx, "/cloudFoundry/credentials", expandCloudFoundryCredentials(d), &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace Cloud Foundry credentials",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.ID)
+
+	resourceDynatraceCloudFoundryCredentialsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceCloudFoundryCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	var credentials cloudFoundryCredentials
+	err := dynatraceConfigRestClientV1.get(ctx, "/cloudFoundry/credentials/"+url.PathEscape(credentialsID), &credentials)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace Cloud Foundry credentials",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The password is never returned, so only a change in the configuration causes a diff
+	d.Set("name", credentials.Name)
+	d.Set("api_url", credentials.ApiUrl)
+	d.Set("login_url", credentials.LoginUrl)
+	d.Set("username", credentials.Username)
+	d.Set("active", credentials.Active)
+	d.Set("endpoint_status", credentials.EndpointStatus)
+
+	return diags
+}
+
+func resourceDynatraceCloudFoundryCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	if d.HasChanges("name", "api_url", "login_url", "username", "password", "active") {
+
+		credentials := expandCloudFoundryCredentials(d)
+		credentials.Id = credentialsID
+
+		err := dynatraceConfigRestClientV1.put(ctx, "/cloudFoundry/credentials/"+url.PathEscape(credentialsID), credentials, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace Cloud Foundry credentials",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceCloudFoundryCredentialsRead(ctx, d, m)
+}
+
+func resourceDynatraceCloudFoundryCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	err := dynatraceConfigRestClientV1.delete(ctx, "/cloudFoundry/credentials/"+url.PathEscape(credentialsID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace Cloud Foundry credentials",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandCloudFoundryCredentials(d *schema.ResourceData) cloudFoundryCredentials {
+	return cloudFoundryCredentials{
+		Name:     d.Get("name").(string),
+		ApiUrl:   d.Get("api_url").(string),
+		LoginUrl: d.Get("login_url").(string),
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+		Active:   d.Get("active").(bool),
+	}
+}
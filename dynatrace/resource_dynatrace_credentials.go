@@ -0,0 +1,264 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// vaultCredentials is the request body of the credential vault. It combines the fields of
+// UserPasswordCredentials, CertificateCredentials and TokenCredentials and adds the scope,
+// which is missing in the official client.
+type vaultCredentials struct {
+	Name              string `json:"name"`
+	Id                string `json:"id,omitempty"`
+	Description       string `json:"description"`
+	OwnerAccessOnly   bool   `json:"ownerAccessOnly"`
+	Scope             string `json:"scope,omitempty"`
+	Type              string `json:"type"`
+	User              string `json:"user,omitempty"`
+	Password          string `json:"password,omitempty"`
+	Certificate       string `json:"certificate,omitempty"`
+	CertificateFormat string `json:"certificateFormat,omitempty"`
+	Token             string `json:"token,omitempty"`
+}
+
+func resourceDynatraceCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceCredentialsCreate,
+		ReadContext:   resourceDynatraceCredentialsRead,
+		UpdateContext: resourceDynatraceCredentialsUpdate,
+		DeleteContext: resourceDynatraceCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the credentials set.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the credentials set.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of the credentials set, either USERNAME_PASSWORD, CERTIFICATE or TOKEN.",
+				ValidateFunc: validation.StringInSlice([]string{"USERNAME_PASSWORD", "CERTIFICATE", "TOKEN"}, false),
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The scope of the credentials set, e.g. SYNTHETIC or EXTENSION.",
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "SYNTHETIC", "EXTENSION", "APP_ENGINE"}, false),
+			},
+			"owner_access_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The credentials set is available to every user (false) or to owner only (true).",
+			},
+			"owner": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The owner of the credentials set.",
+			},
+			"user": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username of a USERNAME_PASSWORD credentials set. Write-only, it is never read back from Dynatrace.",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of a USERNAME_PASSWORD or CERTIFICATE credentials set. Write-only, it is never read back from Dynatrace.",
+			},
+			"certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded certificate of a CERTIFICATE credentials set. Write-only, it is never read back from Dynatrace.",
+			},
+			"certificate_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of the certificate, either UNKNOWN, PEM or PKCS12.",
+				ValidateFunc: validation.StringInSlice([]string{"UNKNOWN", "PEM", "PKCS12"}, false),
+			},
+			"token": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The token of a TOKEN credentials set. Write-only, it is never read back from Dynatrace.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c, err := expandCredentials(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credentialsBody := dynatraceConfigV1.AddCredentialsOpts{
+		Credentials: optional.NewInterface(c),
+	}
+
+	credentials, _, err := dynatraceConfigClientV1.CredentialVaultApi.AddCredentials(authConfigV1, &credentialsBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId(credentials.Id)
+
+	resourceDynatraceCredentialsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	credentials, _, err := dynatraceConfigClientV1.CredentialVaultApi.GetCredentials1(authConfigV1, credentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	// The API only returns the metadata of the credentials set. The user, secrets and scope
+	// keep the configured values, so only a change in the configuration causes a diff.
+	d.Set("name", &credentials.Name)
+	d.Set("description", &credentials.Description)
+	d.Set("type", &credentials.Type)
+	d.Set("owner", &credentials.Owner)
+	d.Set("owner_access_only", &credentials.OwnerAccessOnly)
+
+	return diags
+}
+
+func resourceDynatraceCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	if d.HasChanges("name", "description", "scope", "owner_access_only", "user", "password", "certificate", "certificate_format", "token") {
+
+		c, err := expandCredentials(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		c.Id = credentialsID
+
+		credentialsBody := dynatraceConfigV1.UpdateCredentialsOpts{
+			Credentials: optional.NewInterface(c),
+		}
+
+		_, _, err = dynatraceConfigClientV1.CredentialVaultApi.UpdateCredentials(authConfigV1, credentialsID, &credentialsBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceCredentialsRead(ctx, d, m)
+}
+
+func resourceDynatraceCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	credentialsID := d.Id()
+
+	_, err := dynatraceConfigClientV1.CredentialVaultApi.RemoveCredentials(authConfigV1, credentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandCredentials(d *schema.ResourceData) (vaultCredentials, error) {
+	c := vaultCredentials{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		OwnerAccessOnly: d.Get("owner_access_only").(bool),
+		Scope:           d.Get("scope").(string),
+		Type:            d.Get("type").(string),
+	}
+
+	switch c.Type {
+	case "USERNAME_PASSWORD":
+		c.User = d.Get("user").(string)
+		c.Password = d.Get("password").(string)
+		if c.User == "" || c.Password == "" {
+			return c, fmt.Errorf("user and password are required for credentials of type %s", c.Type)
+		}
+	case "CERTIFICATE":
+		c.Certificate = d.Get("certificate").(string)
+		c.Password = d.Get("password").(string)
+		c.CertificateFormat = d.Get("certificate_format").(string)
+		if c.Certificate == "" || c.CertificateFormat == "" {
+			return c, fmt.Errorf("certificate and certificate_format are required for credentials of type %s", c.Type)
+		}
+	case "TOKEN":
+		c.Token = d.Get("token").(string)
+		if c.Token == "" {
+			return c, fmt.Errorf("token is required for credentials of type %s", c.Type)
+		}
+	}
+
+	return c, nil
+}
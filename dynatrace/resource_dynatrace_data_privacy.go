@@ -0,0 +1,207 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataPrivacyID is the fixed ID of the data privacy singleton.
+const dataPrivacyID = "data_privacy"
+
+// dataPrivacy is the environment-wide data privacy as used by /dataPrivacy. Unlike DataPrivacyAndSecurity
+// of the official client it always sends the audit logging flag, which allows to disable it.
+type dataPrivacy struct {
+	MaskIpAddressesAndGpsCoordinates bool `json:"maskIpAddressesAndGpsCoordinates"`
+	MaskUserActionNames              bool `json:"maskUserActionNames"`
+	MaskPersonalDataInUris           bool `json:"maskPersonalDataInUris"`
+	LogAuditEvents                   bool `json:"logAuditEvents"`
+}
+
+// applicationDataPrivacy is the data privacy of web applications as used by /applications/web/default/dataPrivacy,
+// which applies to all web applications without their own data privacy.
+type applicationDataPrivacy struct {
+	DataCaptureOptInEnabled         bool   `json:"dataCaptureOptInEnabled"`
+	PersistentCookieForUserTracking bool   `json:"persistentCookieForUserTracking"`
+	DoNotTrackBehaviour             string `json:"doNotTrackBehaviour"`
+}
+
+// defaultDataPrivacy and defaultApplicationDataPrivacy are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultDataPrivacy = dataPrivacy{}
+
+var defaultApplicationDataPrivacy = applicationDataPrivacy{
+	PersistentCookieForUserTracking: true,
+	DoNotTrackBehaviour:             "CAPTURE_ANONYMIZED",
+}
+
+func resourceDynatraceDataPrivacy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceDataPrivacyCreate,
+		ReadContext:   resourceDynatraceDataPrivacyRead,
+		UpdateContext: resourceDynatraceDataPrivacyUpdate,
+		DeleteContext: resourceDynatraceDataPrivacyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mask_ip_addresses_and_gps_coordinates": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "IP addresses and GPS coordinates are masked (true) or not (false).",
+			},
+			"mask_user_action_names": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "User action names of web applications are masked (true) or not (false).",
+			},
+			"mask_personal_data_in_uris": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Personal data in URIs is masked (true) or not (false).",
+			},
+			"log_audit_events": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The audit logging is enabled (true) or disabled (false).",
+			},
+			"data_capture_opt_in_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Data capture and cookies of web applications are disabled until the JavaScript API dtrum.enable() is called (true) or not (false).",
+			},
+			"persistent_cookie_for_user_tracking": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "A persistent cookie is set to recognize returning devices (true) or not (false).",
+			},
+			"do_not_track_behaviour": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CAPTURE_ANONYMIZED",
+				Description:  "How to handle the \"Do Not Track\" setting of browsers, either IGNORE_DO_NOT_TRACK, CAPTURE_ANONYMIZED or DO_NOT_CAPTURE.",
+				ValidateFunc: validation.StringInSlice([]string{"IGNORE_DO_NOT_TRACK", "CAPTURE_ANONYMIZED", "DO_NOT_CAPTURE"}, false),
+			},
+		},
+	}
+}
+
+func resourceDynatraceDataPrivacyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, dataPrivacyID, func() diag.Diagnostics {
+		return updateDataPrivacy(ctx, m, expandDataPrivacy(d), expandApplicationDataPrivacy(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceDataPrivacyRead(ctx, d, m)
+}
+
+func resourceDynatraceDataPrivacyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	var privacy dataPrivacy
+	err := dynatraceConfigRestClientV1.get(ctx, "/dataPrivacy", &privacy)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace data privacy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	var applicationPrivacy applicationDataPrivacy
+	err = dynatraceConfigRestClientV1.get(ctx, "/applications/web/default/dataPrivacy", &applicationPrivacy)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace application data privacy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("mask_ip_addresses_and_gps_coordinates", privacy.MaskIpAddressesAndGpsCoordinates)
+	d.Set("mask_user_action_names", privacy.MaskUserActionNames)
+	d.Set("mask_personal_data_in_uris", privacy.MaskPersonalDataInUris)
+	d.Set("log_audit_events", privacy.LogAuditEvents)
+	d.Set("data_capture_opt_in_enabled", applicationPrivacy.DataCaptureOptInEnabled)
+	d.Set("persistent_cookie_for_user_tracking", applicationPrivacy.PersistentCookieForUserTracking)
+	d.Set("do_not_track_behaviour", applicationPrivacy.DoNotTrackBehaviour)
+
+	return diags
+}
+
+func resourceDynatraceDataPrivacyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("mask_ip_addresses_and_gps_coordinates", "mask_user_action_names", "mask_personal_data_in_uris", "log_audit_events",
+		"data_capture_opt_in_enabled", "persistent_cookie_for_user_tracking", "do_not_track_behaviour") {
+		if diags := updateDataPrivacy(ctx, m, expandDataPrivacy(d), expandApplicationDataPrivacy(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceDataPrivacyRead(ctx, d, m)
+}
+
+func resourceDynatraceDataPrivacyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateDataPrivacy(ctx, m, defaultDataPrivacy, defaultApplicationDataPrivacy)
+	})
+}
+
+func updateDataPrivacy(ctx context.Context, m interface{}, privacy dataPrivacy, applicationPrivacy applicationDataPrivacy) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/dataPrivacy", privacy, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace data privacy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = dynatraceConfigRestClientV1.put(ctx, "/applications/web/default/dataPrivacy", applicationPrivacy, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace application data privacy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandDataPrivacy(d *schema.ResourceData) dataPrivacy {
+	return dataPrivacy{
+		MaskIpAddressesAndGpsCoordinates: d.Get("mask_ip_addresses_and_gps_coordinates").(bool),
+		MaskUserActionNames:              d.Get("mask_user_action_names").(bool),
+		MaskPersonalDataInUris:           d.Get("mask_personal_data_in_uris").(bool),
+		LogAuditEvents:                   d.Get("log_audit_events").(bool),
+	}
+}
+
+func expandApplicationDataPrivacy(d *schema.ResourceData) applicationDataPrivacy {
+	return applicationDataPrivacy{
+		DataCaptureOptInEnabled:         d.Get("data_capture_opt_in_enabled").(bool),
+		PersistentCookieForUserTracking: d.Get("persistent_cookie_for_user_tracking").(bool),
+		DoNotTrackBehaviour:             d.Get("do_not_track_behaviour").(string),
+	}
+}
@@ -0,0 +1,212 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// databaseAnomaliesID is the fixed ID of the database anomaly detection singleton.
+const databaseAnomaliesID = "database_anomalies"
+
+// databaseAnomalyDetection is the request body of /anomalyDetection/databaseServices.
+type databaseAnomalyDetection struct {
+	ResponseTimeDegradation        responseTimeDegradationDetection                           `json:"responseTimeDegradation"`
+	LoadDrop                       *dynatraceConfigV1.LoadDropDetectionConfig                 `json:"loadDrop,omitempty"`
+	LoadSpike                      *dynatraceConfigV1.LoadSpikeDetectionConfig                `json:"loadSpike,omitempty"`
+	FailureRateIncrease            failureRateIncreaseDetection                               `json:"failureRateIncrease"`
+	DatabaseConnectionFailureCount dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig `json:"databaseConnectionFailureCount"`
+}
+
+// defaultDatabaseAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultDatabaseAnomalyDetection = databaseAnomalyDetection{
+	ResponseTimeDegradation:        defaultResponseTimeDegradationDetection,
+	LoadDrop:                       &dynatraceConfigV1.LoadDropDetectionConfig{Enabled: false},
+	LoadSpike:                      &dynatraceConfigV1.LoadSpikeDetectionConfig{Enabled: false},
+	FailureRateIncrease:            defaultFailureRateIncreaseDetection,
+	DatabaseConnectionFailureCount: dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig{Enabled: false},
+}
+
+func resourceDynatraceDatabaseAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceDatabaseAnomaliesCreate,
+		ReadContext:   resourceDynatraceDatabaseAnomaliesRead,
+		UpdateContext: resourceDynatraceDatabaseAnomaliesUpdate,
+		DeleteContext: resourceDynatraceDatabaseAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"response_time_degradation": responseTimeDegradationSchema(),
+			"failure_rate_increase":     failureRateIncreaseSchema(),
+			"load_drop":                 loadDropSchema(),
+			"load_spike":                loadSpikeSchema(),
+			"database_connection_failure_count": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The configuration of failed database connections detection. Disabled if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "The detection is enabled (true) or disabled (false).",
+						},
+						"connection_fails_count": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Number of failed database connections during any time_period_minutes minutes period to trigger an alert.",
+						},
+						"time_period_minutes": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The X minutes time period during which the connection_fails_count is evaluated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceDatabaseAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, databaseAnomaliesID, func() diag.Diagnostics {
+		return updateDatabaseAnomalyDetection(m, expandDatabaseAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceDatabaseAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceDatabaseAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	databaseAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionDatabaseServicesApi.GetConfiguration1(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	if err := d.Set("response_time_degradation", flattenResponseTimeDegradation(&databaseAnomalies.ResponseTimeDegradation)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("failure_rate_increase", flattenFailureRateIncrease(&databaseAnomalies.FailureRateIncrease)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("load_drop", flattenLoadDrop(&databaseAnomalies.LoadDrop, d.Get("load_drop").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("load_spike", flattenLoadSpike(&databaseAnomalies.LoadSpike, d.Get("load_spike").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_connection_failure_count", flattenDatabaseConnectionFailureCount(&databaseAnomalies.DatabaseConnectionFailureCount, d.Get("database_connection_failure_count").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceDatabaseAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("response_time_degradation", "failure_rate_increase", "load_drop", "load_spike", "database_connection_failure_count") {
+		if diags := updateDatabaseAnomalyDetection(m, expandDatabaseAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceDatabaseAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceDatabaseAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateDatabaseAnomalyDetection(m, defaultDatabaseAnomalyDetection)
+	})
+}
+
+func updateDatabaseAnomalyDetection(m interface{}, databaseAnomalies databaseAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	databaseAnomaliesBody := dynatraceConfigV1.UpdateConfiguration1Opts{
+		DatabaseAnomalyDetectionConfig: optional.NewInterface(databaseAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionDatabaseServicesApi.UpdateConfiguration1(authConfigV1, &databaseAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandDatabaseAnomalyDetection(d *schema.ResourceData) databaseAnomalyDetection {
+	return databaseAnomalyDetection{
+		ResponseTimeDegradation:        expandResponseTimeDegradation(d.Get("response_time_degradation").([]interface{})),
+		FailureRateIncrease:            expandFailureRateIncrease(d.Get("failure_rate_increase").([]interface{})),
+		LoadDrop:                       expandLoadDrop(d.Get("load_drop").([]interface{})),
+		LoadSpike:                      expandLoadSpike(d.Get("load_spike").([]interface{})),
+		DatabaseConnectionFailureCount: expandDatabaseConnectionFailureCount(d.Get("database_connection_failure_count").([]interface{})),
+	}
+}
+
+func expandDatabaseConnectionFailureCount(connectionFailureCount []interface{}) dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig {
+	if len(connectionFailureCount) == 0 || connectionFailureCount[0] == nil {
+		return dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig{Enabled: false}
+	}
+
+	m := connectionFailureCount[0].(map[string]interface{})
+
+	return dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig{
+		Enabled:              m["enabled"].(bool),
+		ConnectionFailsCount: int32(m["connection_fails_count"].(int)),
+		TimePeriodMinutes:    int32(m["time_period_minutes"].(int)),
+	}
+}
+
+// flattenDatabaseConnectionFailureCount keeps a disabled block of the previous state, see flattenLoadDrop.
+func flattenDatabaseConnectionFailureCount(connectionFailureCount *dynatraceConfigV1.DatabaseConnectionFailureDetectionConfig, previous []interface{}) []interface{} {
+	if connectionFailureCount == nil || !connectionFailureCount.Enabled {
+		if len(previous) == 0 || previous[0] == nil {
+			return make([]interface{}, 0)
+		}
+
+		p := previous[0].(map[string]interface{})
+		p["enabled"] = false
+
+		return []interface{}{p}
+	}
+
+	c := make(map[string]interface{})
+
+	c["enabled"] = connectionFailureCount.Enabled
+	c["connection_fails_count"] = connectionFailureCount.ConnectionFailsCount
+	c["time_period_minutes"] = connectionFailureCount.TimePeriodMinutes
+
+	return []interface{}{c}
+}
@@ -0,0 +1,281 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// diskEventAnomalyDetection is a disk event rule as used by /anomalyDetection/diskEvents. Unlike
+// DiskEventAnomalyDetectionConfig of the official client it supports the host group scope and omits an unset disk name filter.
+type diskEventAnomalyDetection struct {
+	ID               string                            `json:"id,omitempty"`
+	Name             string                            `json:"name"`
+	Enabled          bool                              `json:"enabled"`
+	Metric           string                            `json:"metric"`
+	Threshold        float64                           `json:"threshold"`
+	Samples          int                               `json:"samples"`
+	ViolatingSamples int                               `json:"violatingSamples"`
+	DiskNameFilter   *dynatraceConfigV1.DiskNameFilter `json:"diskNameFilter,omitempty"`
+	TagFilters       []dynatraceConfigV1.TagFilter     `json:"tagFilters,omitempty"`
+	HostGroupID      string                            `json:"hostGroupId,omitempty"`
+}
+
+func resourceDynatraceDiskAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceDiskAnomaliesCreate,
+		ReadContext:   resourceDynatraceDiskAnomaliesRead,
+		UpdateContext: resourceDynatraceDiskAnomaliesUpdate,
+		DeleteContext: resourceDynatraceDiskAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the disk event rule.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The disk event rule is enabled (true) or disabled (false).",
+			},
+			"metric": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The metric to monitor, either LOW_DISK_SPACE, LOW_INODES, READ_TIME_EXCEEDING or WRITE_TIME_EXCEEDING.",
+				ValidateFunc: validation.StringInSlice([]string{"LOW_DISK_SPACE", "LOW_INODES", "READ_TIME_EXCEEDING", "WRITE_TIME_EXCEEDING"}, false),
+			},
+			"threshold": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "The threshold to trigger the disk event. A percentage for LOW_DISK_SPACE or LOW_INODES, milliseconds for READ_TIME_EXCEEDING or WRITE_TIME_EXCEEDING.",
+			},
+			"samples": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of samples to evaluate.",
+			},
+			"violating_samples": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of samples that must violate the threshold to trigger an event. Must not exceed the number of evaluated samples.",
+			},
+			"disk_name": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Narrows the rule down to disks matching the name filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The comparison operator, either CONTAINS, DOES_NOT_CONTAIN, EQUALS, DOES_NOT_EQUAL, STARTS_WITH or DOES_NOT_START_WITH.",
+							ValidateFunc: validation.StringInSlice([]string{"CONTAINS", "DOES_NOT_CONTAIN", "EQUALS", "DOES_NOT_EQUAL", "STARTS_WITH", "DOES_NOT_START_WITH"}, false),
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value to compare the disk name to.",
+						},
+					},
+				},
+			},
+			"tag_filter": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Narrows the rule down to hosts matching the tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The origin of the tag, such as AWS or Cloud Foundry. Custom tags use the CONTEXTLESS value.",
+						},
+						"key": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the tag. Custom tags have the tag value here.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of the tag. Not applicable to custom tags.",
+						},
+					},
+				},
+			},
+			"host_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Narrows the rule down to the hosts of the host group, e.g. HOST_GROUP-1234567890ABCDEF.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceDiskAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	var created entityShortRepresentation
+	err := dynatraceConfigRestClientV1.post(ctx, "/anomalyDetection/diskEvents", expandDiskEventAnomalyDetection(d), &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace disk event rule",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.ID)
+
+	resourceDynatraceDiskAnomaliesRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceDiskAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	diskEventID := d.Id()
+
+	var diskEvent diskEventAnomalyDetection
+	err := dynatraceConfigRestClientV1.get(ctx, "/anomalyDetection/diskEvents/"+url.PathEscape(diskEventID), &diskEvent)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace disk event rule",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("name", diskEvent.Name)
+	d.Set("enabled", diskEvent.Enabled)
+	d.Set("metric", diskEvent.Metric)
+	d.Set("threshold", diskEvent.Threshold)
+	d.Set("samples", diskEvent.Samples)
+	d.Set("violating_samples", diskEvent.ViolatingSamples)
+	d.Set("host_group_id", diskEvent.HostGroupID)
+
+	if err := d.Set("disk_name", flattenDiskNameFilter(diskEvent.DiskNameFilter)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tag_filter", flattenAlertingProfileTagFilters(&diskEvent.TagFilters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceDiskAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	diskEventID := d.Id()
+
+	if d.HasChanges("name", "enabled", "metric", "threshold", "samples", "violating_samples", "disk_name", "tag_filter", "host_group_id") {
+
+		diskEvent := expandDiskEventAnomalyDetection(d)
+		diskEvent.ID = diskEventID
+
+		err := dynatraceConfigRestClientV1.put(ctx, "/anomalyDetection/diskEvents/"+url.PathEscape(diskEventID), diskEvent, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace disk event rule",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceDiskAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceDiskAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	diskEventID := d.Id()
+
+	err := dynatraceConfigRestClientV1.delete(ctx, "/anomalyDetection/diskEvents/"+url.PathEscape(diskEventID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace disk event rule",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandDiskEventAnomalyDetection(d *schema.ResourceData) diskEventAnomalyDetection {
+	return diskEventAnomalyDetection{
+		Name:             d.Get("name").(string),
+		Enabled:          d.Get("enabled").(bool),
+		Metric:           d.Get("metric").(string),
+		Threshold:        d.Get("threshold").(float64),
+		Samples:          d.Get("samples").(int),
+		ViolatingSamples: d.Get("violating_samples").(int),
+		DiskNameFilter:   expandDiskNameFilter(d.Get("disk_name").([]interface{})),
+		TagFilters:       expandAlertingProfileTagFilters(d.Get("tag_filter").([]interface{})),
+		HostGroupID:      d.Get("host_group_id").(string),
+	}
+}
+
+func expandDiskNameFilter(diskNameFilter []interface{}) *dynatraceConfigV1.DiskNameFilter {
+	if len(diskNameFilter) == 0 || diskNameFilter[0] == nil {
+		return nil
+	}
+
+	m := diskNameFilter[0].(map[string]interface{})
+
+	return &dynatraceConfigV1.DiskNameFilter{
+		Operator: m["operator"].(string),
+		Value:    m["value"].(string),
+	}
+}
+
+func flattenDiskNameFilter(diskNameFilter *dynatraceConfigV1.DiskNameFilter) []interface{} {
+	if diskNameFilter == nil {
+		return make([]interface{}, 0)
+	}
+
+	f := make(map[string]interface{})
+
+	f["operator"] = diskNameFilter.Operator
+	f["value"] = diskNameFilter.Value
+
+	return []interface{}{f}
+}
@@ -0,0 +1,329 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// entityTag is a custom tag as used by the Environment v2 API /tags.
+type entityTag struct {
+	Context string `json:"context,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+}
+
+// entityTags is the request body of POST /tags and the response body of GET /tags.
+type entityTags struct {
+	Tags []entityTag `json:"tags"`
+}
+
+func resourceDynatraceEntityTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceEntityTagsCreate,
+		ReadContext:   resourceDynatraceEntityTagsRead,
+		UpdateContext: resourceDynatraceEntityTagsUpdate,
+		DeleteContext: resourceDynatraceEntityTagsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_selector": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity selector of the entities to tag, e.g. type(\"SERVICE\"),entityName(\"carts\").",
+			},
+			"tag": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The custom tags applied to the matching entities. Tags which were already applied, e.g. by other tools, are left untouched.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The key of the tag.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of the tag.",
+						},
+					},
+				},
+			},
+			"owned_tags": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The tags applied by this resource, i.e. the configured tags which weren't applied before. Only these tags are removed from the entities.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key of the tag.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the tag.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceEntityTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := checkTokenScopes(ctx, m, "entities.read", "entities.write"); diags.HasError() {
+		return diags
+	}
+
+	entitySelector := d.Get("entity_selector").(string)
+
+	owned, diags := addEntityTags(ctx, m, entitySelector, expandEntityTags(d.Get("tag").(*schema.Set).List()))
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("owned_tags", flattenEntityTags(&owned)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The entity selector identifies the tagged entities, which allows to import the resource
+	d.SetId(entitySelector)
+
+	return resourceDynatraceEntityTagsRead(ctx, d, m)
+}
+
+func resourceDynatraceEntityTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "entities.read"); diags.HasError() {
+		return diags
+	}
+
+	entitySelector := d.Id()
+
+	applied, diags := appliedEntityTags(ctx, m, entitySelector)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only the tags known to the state are read, a tag removed outside of Terraform causes a diff
+	tags := []entityTag{}
+	for _, tag := range expandEntityTags(d.Get("tag").(*schema.Set).List()) {
+		if applied[tag] {
+			tags = append(tags, tag)
+		}
+	}
+
+	owned := []entityTag{}
+	for _, tag := range expandEntityTags(d.Get("owned_tags").(*schema.Set).List()) {
+		if applied[tag] {
+			owned = append(owned, tag)
+		}
+	}
+
+	d.Set("entity_selector", entitySelector)
+
+	if err := d.Set("tag", flattenEntityTags(&tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("owned_tags", flattenEntityTags(&owned)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceEntityTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := checkTokenScopes(ctx, m, "entities.read", "entities.write"); diags.HasError() {
+		return diags
+	}
+
+	entitySelector := d.Id()
+
+	if d.HasChange("tag") {
+		o, n := d.GetChange("tag")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set))
+		added := n.(*schema.Set).Difference(o.(*schema.Set))
+
+		// Removed tags are only removed from the entities if the resource applied them
+		owned := []entityTag{}
+		ownedRemoved := []entityTag{}
+		for _, tag := range expandEntityTags(d.Get("owned_tags").(*schema.Set).List()) {
+			if removed.Contains(map[string]interface{}{"key": tag.Key, "value": tag.Value}) {
+				ownedRemoved = append(ownedRemoved, tag)
+			} else {
+				owned = append(owned, tag)
+			}
+		}
+
+		if diags := removeEntityTags(ctx, m, entitySelector, ownedRemoved); diags.HasError() {
+			return diags
+		}
+
+		ownedAdded, diags := addEntityTags(ctx, m, entitySelector, expandEntityTags(added.List()))
+		owned = append(owned, ownedAdded...)
+		if err := d.Set("owned_tags", flattenEntityTags(&owned)); err != nil {
+			return diag.FromErr(err)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceEntityTagsRead(ctx, d, m)
+}
+
+func resourceDynatraceEntityTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := checkTokenScopes(ctx, m, "entities.write"); diags.HasError() {
+		return diags
+	}
+
+	// Only the tags applied by the resource are removed from the entities
+	if diags := removeEntityTags(ctx, m, d.Id(), expandEntityTags(d.Get("owned_tags").(*schema.Set).List())); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// appliedEntityTags returns the custom tags which are currently applied to the entities matching the entity selector.
+func appliedEntityTags(ctx context.Context, m interface{}, entitySelector string) (map[entityTag]bool, diag.Diagnostics) {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	var tags entityTags
+	err := dynatraceEnvRestClientV2.get(ctx, "/tags?"+url.Values{"entitySelector": {entitySelector}}.Encode(), &tags)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace entity tags",
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+
+	applied := make(map[entityTag]bool)
+	for _, tag := range tags.Tags {
+		if tag.Context == "CONTEXTLESS" {
+			applied[entityTag{Key: tag.Key, Value: tag.Value}] = true
+		}
+	}
+
+	return applied, diags
+}
+
+// addEntityTags applies the tags which aren't applied yet and returns them, as they are owned by the resource.
+func addEntityTags(ctx context.Context, m interface{}, entitySelector string, tags []entityTag) ([]entityTag, diag.Diagnostics) {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if len(tags) == 0 {
+		return []entityTag{}, diags
+	}
+
+	applied, diags := appliedEntityTags(ctx, m, entitySelector)
+	if diags.HasError() {
+		return []entityTag{}, diags
+	}
+
+	added := []entityTag{}
+	for _, tag := range tags {
+		if !applied[tag] {
+			added = append(added, tag)
+		}
+	}
+
+	if len(added) == 0 {
+		return added, diags
+	}
+
+	err := dynatraceEnvRestClientV2.post(ctx, "/tags?"+url.Values{"entitySelector": {entitySelector}}.Encode(), entityTags{Tags: added}, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace entity tags",
+			Detail:   err.Error(),
+		})
+		return []entityTag{}, diags
+	}
+
+	return added, diags
+}
+
+func removeEntityTags(ctx context.Context, m interface{}, entitySelector string, tags []entityTag) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	for _, tag := range tags {
+		query := url.Values{
+			"entitySelector": {entitySelector},
+			"key":            {tag.Key},
+		}
+		if tag.Value != "" {
+			query.Set("value", tag.Value)
+		}
+
+		err := dynatraceEnvRestClientV2.delete(ctx, "/tags?"+query.Encode())
+		if err != nil && !isNotFound(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to delete dynatrace entity tags",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func expandEntityTags(tags []interface{}) []entityTag {
+	ets := make([]entityTag, 0, len(tags))
+
+	for _, tag := range tags {
+		m := tag.(map[string]interface{})
+
+		ets = append(ets, entityTag{
+			Key:   m["key"].(string),
+			Value: m["value"].(string),
+		})
+	}
+
+	return ets
+}
+
+func flattenEntityTags(tags *[]entityTag) []interface{} {
+	if tags != nil {
+		ets := make([]interface{}, len(*tags), len(*tags))
+
+		for i, tag := range *tags {
+			et := make(map[string]interface{})
+
+			et["key"] = tag.Key
+			et["value"] = tag.Value
+			ets[i] = et
+		}
+
+		return ets
+	}
+
+	return make([]interface{}, 0)
+}
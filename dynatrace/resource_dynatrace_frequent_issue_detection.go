@@ -0,0 +1,136 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// frequentIssueDetectionID is the fixed ID of the frequent issue detection singleton.
+const frequentIssueDetectionID = "frequent_issue_detection"
+
+// defaultFrequentIssueDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultFrequentIssueDetection = dynatraceConfigV1.FrequentIssueDetectionConfig{
+	FrequentIssueDetectionApplicationEnabled:    true,
+	FrequentIssueDetectionServiceEnabled:        true,
+	FrequentIssueDetectionInfrastructureEnabled: true,
+}
+
+func resourceDynatraceFrequentIssueDetection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceFrequentIssueDetectionCreate,
+		ReadContext:   resourceDynatraceFrequentIssueDetectionRead,
+		UpdateContext: resourceDynatraceFrequentIssueDetectionUpdate,
+		DeleteContext: resourceDynatraceFrequentIssueDetectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The frequent issue detection for applications is enabled (true) or disabled (false).",
+			},
+			"service_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The frequent issue detection for services is enabled (true) or disabled (false).",
+			},
+			"infrastructure_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The frequent issue detection for infrastructure is enabled (true) or disabled (false).",
+			},
+		},
+	}
+}
+
+func resourceDynatraceFrequentIssueDetectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, frequentIssueDetectionID, func() diag.Diagnostics {
+		return updateFrequentIssueDetection(m, expandFrequentIssueDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceFrequentIssueDetectionRead(ctx, d, m)
+}
+
+func resourceDynatraceFrequentIssueDetectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	frequentIssueDetection, _, err := dynatraceConfigClientV1.FrequentIssueDetectionApi.GetConfiguration5(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.Set("application_enabled", &frequentIssueDetection.FrequentIssueDetectionApplicationEnabled)
+	d.Set("service_enabled", &frequentIssueDetection.FrequentIssueDetectionServiceEnabled)
+	d.Set("infrastructure_enabled", &frequentIssueDetection.FrequentIssueDetectionInfrastructureEnabled)
+
+	return diags
+}
+
+func resourceDynatraceFrequentIssueDetectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("application_enabled", "service_enabled", "infrastructure_enabled") {
+		if diags := updateFrequentIssueDetection(m, expandFrequentIssueDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceFrequentIssueDetectionRead(ctx, d, m)
+}
+
+func resourceDynatraceFrequentIssueDetectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateFrequentIssueDetection(m, defaultFrequentIssueDetection)
+	})
+}
+
+func updateFrequentIssueDetection(m interface{}, frequentIssueDetection dynatraceConfigV1.FrequentIssueDetectionConfig) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	frequentIssueDetectionBody := dynatraceConfigV1.UpdateConfiguration3Opts{
+		FrequentIssueDetectionConfig: optional.NewInterface(frequentIssueDetection),
+	}
+
+	_, err := dynatraceConfigClientV1.FrequentIssueDetectionApi.UpdateConfiguration3(authConfigV1, &frequentIssueDetectionBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandFrequentIssueDetection(d *schema.ResourceData) dynatraceConfigV1.FrequentIssueDetectionConfig {
+	return dynatraceConfigV1.FrequentIssueDetectionConfig{
+		FrequentIssueDetectionApplicationEnabled:    d.Get("application_enabled").(bool),
+		FrequentIssueDetectionServiceEnabled:        d.Get("service_enabled").(bool),
+		FrequentIssueDetectionInfrastructureEnabled: d.Get("infrastructure_enabled").(bool),
+	}
+}
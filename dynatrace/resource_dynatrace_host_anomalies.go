@@ -0,0 +1,543 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// hostAnomaliesID is the fixed ID of the host anomaly detection singleton.
+const hostAnomaliesID = "host_anomalies"
+
+// hostsAnomalyDetection is the request body of /anomalyDetection/hosts.
+type hostsAnomalyDetection struct {
+	ConnectionLostDetection            dynatraceConfigV1.ConnectionLostDetectionConfig `json:"connectionLostDetection"`
+	HighCpuSaturationDetection         customThresholdDetection                        `json:"highCpuSaturationDetection"`
+	HighMemoryDetection                customThresholdDetection                        `json:"highMemoryDetection"`
+	HighGcActivityDetection            customThresholdDetection                        `json:"highGcActivityDetection"`
+	OutOfMemoryDetection               customThresholdDetection                        `json:"outOfMemoryDetection"`
+	OutOfThreadsDetection              customThresholdDetection                        `json:"outOfThreadsDetection"`
+	NetworkDroppedPacketsDetection     customThresholdDetection                        `json:"networkDroppedPacketsDetection"`
+	NetworkErrorsDetection             customThresholdDetection                        `json:"networkErrorsDetection"`
+	HighNetworkDetection               customThresholdDetection                        `json:"highNetworkDetection"`
+	NetworkTcpProblemsDetection        customThresholdDetection                        `json:"networkTcpProblemsDetection"`
+	NetworkHighRetransmissionDetection customThresholdDetection                        `json:"networkHighRetransmissionDetection"`
+	DiskLowSpaceDetection              customThresholdDetection                        `json:"diskLowSpaceDetection"`
+	DiskSlowWritesAndReadsDetection    customThresholdDetection                        `json:"diskSlowWritesAndReadsDetection"`
+	DiskLowInodesDetection             customThresholdDetection                        `json:"diskLowInodesDetection"`
+}
+
+// defaultHostsAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultHostsAnomalyDetection = hostsAnomalyDetection{
+	ConnectionLostDetection:            dynatraceConfigV1.ConnectionLostDetectionConfig{Enabled: true, EnabledOnGracefulShutdowns: false},
+	HighCpuSaturationDetection:         customThresholdDetection{Enabled: true},
+	HighMemoryDetection:                customThresholdDetection{Enabled: true},
+	HighGcActivityDetection:            customThresholdDetection{Enabled: true},
+	OutOfMemoryDetection:               customThresholdDetection{Enabled: true},
+	OutOfThreadsDetection:              customThresholdDetection{Enabled: true},
+	NetworkDroppedPacketsDetection:     customThresholdDetection{Enabled: true},
+	NetworkErrorsDetection:             customThresholdDetection{Enabled: true},
+	HighNetworkDetection:               customThresholdDetection{Enabled: false},
+	NetworkTcpProblemsDetection:        customThresholdDetection{Enabled: false},
+	NetworkHighRetransmissionDetection: customThresholdDetection{Enabled: false},
+	DiskLowSpaceDetection:              customThresholdDetection{Enabled: true},
+	DiskSlowWritesAndReadsDetection:    customThresholdDetection{Enabled: true},
+	DiskLowInodesDetection:             customThresholdDetection{Enabled: true},
+}
+
+func resourceDynatraceHostAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceHostAnomaliesCreate,
+		ReadContext:   resourceDynatraceHostAnomaliesRead,
+		UpdateContext: resourceDynatraceHostAnomaliesUpdate,
+		DeleteContext: resourceDynatraceHostAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_lost_detection": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration of lost connection detection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "The detection is enabled (true) or disabled (false).",
+						},
+						"enabled_on_graceful_shutdowns": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Alert on graceful host shutdowns (true) or not (false).",
+						},
+					},
+				},
+			},
+			"high_cpu_saturation_detection": customThresholdDetectionSchema("Configuration of high CPU saturation detection.", map[string]*schema.Schema{
+				"cpu_saturation": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if CPU usage is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+			"high_memory_detection": customThresholdDetectionSchema("Configuration of high memory usage detection.", map[string]*schema.Schema{
+				"page_faults_per_second_windows": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the memory page fault rate on Windows is higher than X faults per second.",
+				},
+				"used_memory_percentage_windows": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the memory usage on Windows is higher than X %.",
+				},
+				"page_faults_per_second_non_windows": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the memory page fault rate on Unix systems is higher than X faults per second.",
+				},
+				"used_memory_percentage_non_windows": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the memory usage on Unix systems is higher than X %.",
+				},
+			}),
+			"high_gc_activity_detection": customThresholdDetectionSchema("Configuration of high Garbage Collector activity detection.", map[string]*schema.Schema{
+				"gc_time_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the GC time is higher than X %.",
+				},
+				"gc_suspension_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the GC suspension is higher than X %.",
+				},
+			}),
+			"out_of_memory_detection": customThresholdDetectionSchema("Configuration of Java and .NET out of memory problems detection.", map[string]*schema.Schema{
+				"out_of_memory_exceptions_number": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of Java/.NET out of memory exceptions is at least X per minute.",
+				},
+			}),
+			"out_of_threads_detection": customThresholdDetectionSchema("Configuration of Java out of threads problems detection.", map[string]*schema.Schema{
+				"out_of_threads_exceptions_number": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of Java out of threads exceptions is at least X per minute.",
+				},
+			}),
+			"network_dropped_packets_detection": customThresholdDetectionSchema("Configuration of high number of dropped packets detection.", map[string]*schema.Schema{
+				"dropped_packets_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the dropped packet percentage is higher than X % in 3 out of 5 samples.",
+				},
+				"total_packets_rate": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the total packet rate is higher than X packets per second in 3 out of 5 samples.",
+				},
+			}),
+			"network_errors_detection": customThresholdDetectionSchema("Configuration of high number of network errors detection.", map[string]*schema.Schema{
+				"errors_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the receive/transmit error packet percentage is higher than X % in 3 out of 5 samples.",
+				},
+				"total_packets_rate": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the total packet rate is higher than X packets per second in 3 out of 5 samples.",
+				},
+			}),
+			"high_network_detection": customThresholdDetectionSchema("Configuration of high network utilization detection.", map[string]*schema.Schema{
+				"utilization_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if sent/received traffic utilization is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+			"network_tcp_problems_detection": customThresholdDetectionSchema("Configuration of TCP connectivity problems detection.", map[string]*schema.Schema{
+				"new_connection_failures_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the percentage of new connection failures is higher than X % in 3 out of 5 samples.",
+				},
+				"failed_connections_number_per_minute": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of failed connections is higher than X connections per minute in 3 out of 5 samples.",
+				},
+			}),
+			"network_high_retransmission_detection": customThresholdDetectionSchema("Configuration of high retransmission rate detection.", map[string]*schema.Schema{
+				"retransmission_rate_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the retransmission rate is higher than X % in 3 out of 5 samples.",
+				},
+				"retransmitted_packets_number_per_minute": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of retransmitted packets is higher than X per minute in 3 out of 5 samples.",
+				},
+			}),
+			"disk_low_space_detection": customThresholdDetectionSchema("Configuration of low disk space detection.", map[string]*schema.Schema{
+				"free_space_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if free disk space is lower than X % in 3 out of 5 samples.",
+				},
+			}),
+			"disk_slow_writes_and_reads_detection": customThresholdDetectionSchema("Configuration of slow running disks detection.", map[string]*schema.Schema{
+				"write_and_read_time": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if disk read time or write time is higher than X milliseconds in 3 out of 5 samples.",
+				},
+			}),
+			"disk_low_inodes_detection": customThresholdDetectionSchema("Configuration of low disk inodes number detection.", map[string]*schema.Schema{
+				"free_inodes_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the percentage of available inodes is lower than X % in 3 out of 5 samples.",
+				},
+			}),
+		},
+	}
+}
+
+func resourceDynatraceHostAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, hostAnomaliesID, func() diag.Diagnostics {
+		return updateHostsAnomalyDetection(m, expandHostsAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceHostAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceHostAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	hostAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionHostsApi.GetHostEventsConfig(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	for key, detection := range flattenHostsAnomalyDetection(&hostAnomalies) {
+		if err := d.Set(key, detection); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDynatraceHostAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("connection_lost_detection", "high_cpu_saturation_detection", "high_memory_detection", "high_gc_activity_detection", "out_of_memory_detection", "out_of_threads_detection", "network_dropped_packets_detection", "network_errors_detection", "high_network_detection", "network_tcp_problems_detection", "network_high_retransmission_detection", "disk_low_space_detection", "disk_slow_writes_and_reads_detection", "disk_low_inodes_detection") {
+		if diags := updateHostsAnomalyDetection(m, expandHostsAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceHostAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceHostAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateHostsAnomalyDetection(m, defaultHostsAnomalyDetection)
+	})
+}
+
+func updateHostsAnomalyDetection(m interface{}, hostAnomalies hostsAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	hostAnomaliesBody := dynatraceConfigV1.UpdateHostEventsConfigOpts{
+		HostsAnomalyDetectionConfig: optional.NewInterface(hostAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionHostsApi.UpdateHostEventsConfig(authConfigV1, &hostAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandHostsAnomalyDetection(d *schema.ResourceData) hostsAnomalyDetection {
+	var hostAnomalies hostsAnomalyDetection
+
+	if v := d.Get("connection_lost_detection").([]interface{}); len(v) != 0 && v[0] != nil {
+		c := v[0].(map[string]interface{})
+
+		hostAnomalies.ConnectionLostDetection = dynatraceConfigV1.ConnectionLostDetectionConfig{
+			Enabled:                    c["enabled"].(bool),
+			EnabledOnGracefulShutdowns: c["enabled_on_graceful_shutdowns"].(bool),
+		}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("high_cpu_saturation_detection").([]interface{})); t != nil {
+		hostAnomalies.HighCpuSaturationDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.HighCpuSaturationThresholds{
+			CpuSaturation: int32(t["cpu_saturation"].(int)),
+		}}
+	} else {
+		hostAnomalies.HighCpuSaturationDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("high_memory_detection").([]interface{})); t != nil {
+		hostAnomalies.HighMemoryDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.HighMemoryThresholds{
+			PageFaultsPerSecondWindows:     int32(t["page_faults_per_second_windows"].(int)),
+			UsedMemoryPercentageWindows:    int32(t["used_memory_percentage_windows"].(int)),
+			PageFaultsPerSecondNonWindows:  int32(t["page_faults_per_second_non_windows"].(int)),
+			UsedMemoryPercentageNonWindows: int32(t["used_memory_percentage_non_windows"].(int)),
+		}}
+	} else {
+		hostAnomalies.HighMemoryDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("high_gc_activity_detection").([]interface{})); t != nil {
+		hostAnomalies.HighGcActivityDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.HighGcActivityThresholds{
+			GcTimePercentage:       int32(t["gc_time_percentage"].(int)),
+			GcSuspensionPercentage: int32(t["gc_suspension_percentage"].(int)),
+		}}
+	} else {
+		hostAnomalies.HighGcActivityDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("out_of_memory_detection").([]interface{})); t != nil {
+		hostAnomalies.OutOfMemoryDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.OutOfMemoryThresholds{
+			OutOfMemoryExceptionsNumber: int32(t["out_of_memory_exceptions_number"].(int)),
+		}}
+	} else {
+		hostAnomalies.OutOfMemoryDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("out_of_threads_detection").([]interface{})); t != nil {
+		hostAnomalies.OutOfThreadsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.OutOfThreadsThresholds{
+			OutOfThreadsExceptionsNumber: int32(t["out_of_threads_exceptions_number"].(int)),
+		}}
+	} else {
+		hostAnomalies.OutOfThreadsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("network_dropped_packets_detection").([]interface{})); t != nil {
+		hostAnomalies.NetworkDroppedPacketsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.NetworkDroppedPacketsThresholds{
+			DroppedPacketsPercentage: int32(t["dropped_packets_percentage"].(int)),
+			TotalPacketsRate:         int32(t["total_packets_rate"].(int)),
+		}}
+	} else {
+		hostAnomalies.NetworkDroppedPacketsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("network_errors_detection").([]interface{})); t != nil {
+		hostAnomalies.NetworkErrorsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.NetworkErrorsThresholds{
+			ErrorsPercentage: int32(t["errors_percentage"].(int)),
+			TotalPacketsRate: int32(t["total_packets_rate"].(int)),
+		}}
+	} else {
+		hostAnomalies.NetworkErrorsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("high_network_detection").([]interface{})); t != nil {
+		hostAnomalies.HighNetworkDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.HighNetworkThresholds{
+			UtilizationPercentage: int32(t["utilization_percentage"].(int)),
+		}}
+	} else {
+		hostAnomalies.HighNetworkDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("network_tcp_problems_detection").([]interface{})); t != nil {
+		hostAnomalies.NetworkTcpProblemsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.NetworkTcpProblemsThresholds{
+			NewConnectionFailuresPercentage:  int32(t["new_connection_failures_percentage"].(int)),
+			FailedConnectionsNumberPerMinute: int32(t["failed_connections_number_per_minute"].(int)),
+		}}
+	} else {
+		hostAnomalies.NetworkTcpProblemsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("network_high_retransmission_detection").([]interface{})); t != nil {
+		hostAnomalies.NetworkHighRetransmissionDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.NetworkHighRetransmissionThresholds{
+			RetransmissionRatePercentage:        int32(t["retransmission_rate_percentage"].(int)),
+			RetransmittedPacketsNumberPerMinute: int32(t["retransmitted_packets_number_per_minute"].(int)),
+		}}
+	} else {
+		hostAnomalies.NetworkHighRetransmissionDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("disk_low_space_detection").([]interface{})); t != nil {
+		hostAnomalies.DiskLowSpaceDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.DiskLowSpaceThresholds{
+			FreeSpacePercentage: int32(t["free_space_percentage"].(int)),
+		}}
+	} else {
+		hostAnomalies.DiskLowSpaceDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("disk_slow_writes_and_reads_detection").([]interface{})); t != nil {
+		hostAnomalies.DiskSlowWritesAndReadsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.DiskSlowWriteAndReadsThresholds{
+			WriteAndReadTime: int32(t["write_and_read_time"].(int)),
+		}}
+	} else {
+		hostAnomalies.DiskSlowWritesAndReadsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("disk_low_inodes_detection").([]interface{})); t != nil {
+		hostAnomalies.DiskLowInodesDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.DiskLowInodesThresholds{
+			FreeInodesPercentage: int32(t["free_inodes_percentage"].(int)),
+		}}
+	} else {
+		hostAnomalies.DiskLowInodesDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	return hostAnomalies
+}
+
+func flattenHostsAnomalyDetection(hostAnomalies *dynatraceConfigV1.HostsAnomalyDetectionConfig) map[string][]interface{} {
+	h := make(map[string][]interface{})
+
+	h["connection_lost_detection"] = []interface{}{map[string]interface{}{
+		"enabled":                       hostAnomalies.ConnectionLostDetection.Enabled,
+		"enabled_on_graceful_shutdowns": hostAnomalies.ConnectionLostDetection.EnabledOnGracefulShutdowns,
+	}}
+
+	var highCpuSaturationDetection map[string]interface{}
+	if (dynatraceConfigV1.HighCpuSaturationThresholds{}) != hostAnomalies.HighCpuSaturationDetection.CustomThresholds {
+		highCpuSaturationDetection = map[string]interface{}{
+			"cpu_saturation": hostAnomalies.HighCpuSaturationDetection.CustomThresholds.CpuSaturation,
+		}
+	}
+	h["high_cpu_saturation_detection"] = flattenCustomThresholdDetection(hostAnomalies.HighCpuSaturationDetection.Enabled, highCpuSaturationDetection)
+
+	var highMemoryDetection map[string]interface{}
+	if (dynatraceConfigV1.HighMemoryThresholds{}) != hostAnomalies.HighMemoryDetection.CustomThresholds {
+		highMemoryDetection = map[string]interface{}{
+			"page_faults_per_second_windows":     hostAnomalies.HighMemoryDetection.CustomThresholds.PageFaultsPerSecondWindows,
+			"used_memory_percentage_windows":     hostAnomalies.HighMemoryDetection.CustomThresholds.UsedMemoryPercentageWindows,
+			"page_faults_per_second_non_windows": hostAnomalies.HighMemoryDetection.CustomThresholds.PageFaultsPerSecondNonWindows,
+			"used_memory_percentage_non_windows": hostAnomalies.HighMemoryDetection.CustomThresholds.UsedMemoryPercentageNonWindows,
+		}
+	}
+	h["high_memory_detection"] = flattenCustomThresholdDetection(hostAnomalies.HighMemoryDetection.Enabled, highMemoryDetection)
+
+	var highGcActivityDetection map[string]interface{}
+	if (dynatraceConfigV1.HighGcActivityThresholds{}) != hostAnomalies.HighGcActivityDetection.CustomThresholds {
+		highGcActivityDetection = map[string]interface{}{
+			"gc_time_percentage":       hostAnomalies.HighGcActivityDetection.CustomThresholds.GcTimePercentage,
+			"gc_suspension_percentage": hostAnomalies.HighGcActivityDetection.CustomThresholds.GcSuspensionPercentage,
+		}
+	}
+	h["high_gc_activity_detection"] = flattenCustomThresholdDetection(hostAnomalies.HighGcActivityDetection.Enabled, highGcActivityDetection)
+
+	var outOfMemoryDetection map[string]interface{}
+	if (dynatraceConfigV1.OutOfMemoryThresholds{}) != hostAnomalies.OutOfMemoryDetection.CustomThresholds {
+		outOfMemoryDetection = map[string]interface{}{
+			"out_of_memory_exceptions_number": hostAnomalies.OutOfMemoryDetection.CustomThresholds.OutOfMemoryExceptionsNumber,
+		}
+	}
+	h["out_of_memory_detection"] = flattenCustomThresholdDetection(hostAnomalies.OutOfMemoryDetection.Enabled, outOfMemoryDetection)
+
+	var outOfThreadsDetection map[string]interface{}
+	if (dynatraceConfigV1.OutOfThreadsThresholds{}) != hostAnomalies.OutOfThreadsDetection.CustomThresholds {
+		outOfThreadsDetection = map[string]interface{}{
+			"out_of_threads_exceptions_number": hostAnomalies.OutOfThreadsDetection.CustomThresholds.OutOfThreadsExceptionsNumber,
+		}
+	}
+	h["out_of_threads_detection"] = flattenCustomThresholdDetection(hostAnomalies.OutOfThreadsDetection.Enabled, outOfThreadsDetection)
+
+	var networkDroppedPacketsDetection map[string]interface{}
+	if (dynatraceConfigV1.NetworkDroppedPacketsThresholds{}) != hostAnomalies.NetworkDroppedPacketsDetection.CustomThresholds {
+		networkDroppedPacketsDetection = map[string]interface{}{
+			"dropped_packets_percentage": hostAnomalies.NetworkDroppedPacketsDetection.CustomThresholds.DroppedPacketsPercentage,
+			"total_packets_rate":         hostAnomalies.NetworkDroppedPacketsDetection.CustomThresholds.TotalPacketsRate,
+		}
+	}
+	h["network_dropped_packets_detection"] = flattenCustomThresholdDetection(hostAnomalies.NetworkDroppedPacketsDetection.Enabled, networkDroppedPacketsDetection)
+
+	var networkErrorsDetection map[string]interface{}
+	if (dynatraceConfigV1.NetworkErrorsThresholds{}) != hostAnomalies.NetworkErrorsDetection.CustomThresholds {
+		networkErrorsDetection = map[string]interface{}{
+			"errors_percentage":  hostAnomalies.NetworkErrorsDetection.CustomThresholds.ErrorsPercentage,
+			"total_packets_rate": hostAnomalies.NetworkErrorsDetection.CustomThresholds.TotalPacketsRate,
+		}
+	}
+	h["network_errors_detection"] = flattenCustomThresholdDetection(hostAnomalies.NetworkErrorsDetection.Enabled, networkErrorsDetection)
+
+	var highNetworkDetection map[string]interface{}
+	if (dynatraceConfigV1.HighNetworkThresholds{}) != hostAnomalies.HighNetworkDetection.CustomThresholds {
+		highNetworkDetection = map[string]interface{}{
+			"utilization_percentage": hostAnomalies.HighNetworkDetection.CustomThresholds.UtilizationPercentage,
+		}
+	}
+	h["high_network_detection"] = flattenCustomThresholdDetection(hostAnomalies.HighNetworkDetection.Enabled, highNetworkDetection)
+
+	var networkTcpProblemsDetection map[string]interface{}
+	if (dynatraceConfigV1.NetworkTcpProblemsThresholds{}) != hostAnomalies.NetworkTcpProblemsDetection.CustomThresholds {
+		networkTcpProblemsDetection = map[string]interface{}{
+			"new_connection_failures_percentage":   hostAnomalies.NetworkTcpProblemsDetection.CustomThresholds.NewConnectionFailuresPercentage,
+			"failed_connections_number_per_minute": hostAnomalies.NetworkTcpProblemsDetection.CustomThresholds.FailedConnectionsNumberPerMinute,
+		}
+	}
+	h["network_tcp_problems_detection"] = flattenCustomThresholdDetection(hostAnomalies.NetworkTcpProblemsDetection.Enabled, networkTcpProblemsDetection)
+
+	var networkHighRetransmissionDetection map[string]interface{}
+	if (dynatraceConfigV1.NetworkHighRetransmissionThresholds{}) != hostAnomalies.NetworkHighRetransmissionDetection.CustomThresholds {
+		networkHighRetransmissionDetection = map[string]interface{}{
+			"retransmission_rate_percentage":          hostAnomalies.NetworkHighRetransmissionDetection.CustomThresholds.RetransmissionRatePercentage,
+			"retransmitted_packets_number_per_minute": hostAnomalies.NetworkHighRetransmissionDetection.CustomThresholds.RetransmittedPacketsNumberPerMinute,
+		}
+	}
+	h["network_high_retransmission_detection"] = flattenCustomThresholdDetection(hostAnomalies.NetworkHighRetransmissionDetection.Enabled, networkHighRetransmissionDetection)
+
+	var diskLowSpaceDetection map[string]interface{}
+	if (dynatraceConfigV1.DiskLowSpaceThresholds{}) != hostAnomalies.DiskLowSpaceDetection.CustomThresholds {
+		diskLowSpaceDetection = map[string]interface{}{
+			"free_space_percentage": hostAnomalies.DiskLowSpaceDetection.CustomThresholds.FreeSpacePercentage,
+		}
+	}
+	h["disk_low_space_detection"] = flattenCustomThresholdDetection(hostAnomalies.DiskLowSpaceDetection.Enabled, diskLowSpaceDetection)
+
+	var diskSlowWritesAndReadsDetection map[string]interface{}
+	if (dynatraceConfigV1.DiskSlowWriteAndReadsThresholds{}) != hostAnomalies.DiskSlowWritesAndReadsDetection.CustomThresholds {
+		diskSlowWritesAndReadsDetection = map[string]interface{}{
+			"write_and_read_time": hostAnomalies.DiskSlowWritesAndReadsDetection.CustomThresholds.WriteAndReadTime,
+		}
+	}
+	h["disk_slow_writes_and_reads_detection"] = flattenCustomThresholdDetection(hostAnomalies.DiskSlowWritesAndReadsDetection.Enabled, diskSlowWritesAndReadsDetection)
+
+	var diskLowInodesDetection map[string]interface{}
+	if (dynatraceConfigV1.DiskLowInodesThresholds{}) != hostAnomalies.DiskLowInodesDetection.CustomThresholds {
+		diskLowInodesDetection = map[string]interface{}{
+			"free_inodes_percentage": hostAnomalies.DiskLowInodesDetection.CustomThresholds.FreeInodesPercentage,
+		}
+	}
+	h["disk_low_inodes_detection"] = flattenCustomThresholdDetection(hostAnomalies.DiskLowInodesDetection.Enabled, diskLowInodesDetection)
+
+	return h
+}
@@ -0,0 +1,140 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultHostGroupAutoUpdate are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultHostGroupAutoUpdate = oneAgentAutoUpdate{
+	Setting: "INHERITED",
+	UpdateWindows: oneAgentUpdateWindows{
+		Windows: []oneAgentUpdateWindow{},
+	},
+}
+
+func resourceDynatraceHostGroupAutoUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceHostGroupAutoUpdateCreate,
+		ReadContext:   resourceDynatraceHostGroupAutoUpdateRead,
+		UpdateContext: resourceDynatraceHostGroupAutoUpdateUpdate,
+		DeleteContext: resourceDynatraceHostGroupAutoUpdateDelete,
+		CustomizeDiff: validateOneAgentAutoUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the host group, e.g. HOST_GROUP-1234567890ABCDEF.",
+			},
+			"auto_update_setting": oneAgentAutoUpdateSettingSchema([]string{"ENABLED", "DISABLED", "INHERITED"}, "INHERITED"),
+			"target_version":      oneAgentTargetVersionSchema(),
+			"update_windows":      oneAgentUpdateWindowsSchema(),
+			"effective_setting": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The auto-update setting in effect for the host group, after resolving INHERITED.",
+			},
+			"effective_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OneAgent version in effect for the host group, after resolving INHERITED.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceHostGroupAutoUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	hostGroupID := d.Get("host_group_id").(string)
+
+	// Every host group has an auto-update configuration, creating the resource takes over the configuration with the desired state
+	if diags := updateHostGroupAutoUpdate(ctx, m, hostGroupID, expandOneAgentAutoUpdate(d)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(hostGroupID)
+
+	return resourceDynatraceHostGroupAutoUpdateRead(ctx, d, m)
+}
+
+func resourceDynatraceHostGroupAutoUpdateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	hostGroupID := d.Id()
+
+	var autoUpdate oneAgentAutoUpdate
+	err := dynatraceConfigRestClientV1.get(ctx, "/hostgroups/"+url.PathEscape(hostGroupID)+"/autoupdate", &autoUpdate)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace host group auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The ID is the host group ID, which allows to import the resource
+	d.Set("host_group_id", hostGroupID)
+
+	if err := setOneAgentAutoUpdate(d, &autoUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOneAgentEffectiveAutoUpdate(d, &autoUpdate)
+
+	return diags
+}
+
+func resourceDynatraceHostGroupAutoUpdateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("auto_update_setting", "target_version", "update_windows") {
+		if diags := updateHostGroupAutoUpdate(ctx, m, d.Id(), expandOneAgentAutoUpdate(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceHostGroupAutoUpdateRead(ctx, d, m)
+}
+
+func resourceDynatraceHostGroupAutoUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The configuration can't be deleted, destroying the resource restores the Dynatrace defaults
+	if diags := updateHostGroupAutoUpdate(ctx, m, d.Id(), defaultHostGroupAutoUpdate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func updateHostGroupAutoUpdate(ctx context.Context, m interface{}, hostGroupID string, autoUpdate oneAgentAutoUpdate) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/hostgroups/"+url.PathEscape(hostGroupID)+"/autoupdate", autoUpdate, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace host group auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,205 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// hostMonitoring is the monitoring configuration of a host as used by /hosts/{id}/monitoring.
+type hostMonitoring struct {
+	MonitoringEnabled    bool   `json:"monitoringEnabled"`
+	MonitoringMode       string `json:"monitoringMode"`
+	AutoInjectionEnabled bool   `json:"autoInjectionEnabled"`
+}
+
+// defaultHostMonitoring and defaultHostAutoUpdate are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultHostMonitoring = hostMonitoring{
+	MonitoringEnabled:    true,
+	MonitoringMode:       "FULL_STACK",
+	AutoInjectionEnabled: true,
+}
+
+var defaultHostAutoUpdate = oneAgentAutoUpdate{
+	Setting: "INHERITED",
+	UpdateWindows: oneAgentUpdateWindows{
+		Windows: []oneAgentUpdateWindow{},
+	},
+}
+
+func resourceDynatraceHostMonitoring() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceHostMonitoringCreate,
+		ReadContext:   resourceDynatraceHostMonitoringRead,
+		UpdateContext: resourceDynatraceHostMonitoringUpdate,
+		DeleteContext: resourceDynatraceHostMonitoringDelete,
+		CustomizeDiff: validateOneAgentAutoUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the host, e.g. HOST-1234567890ABCDEF.",
+			},
+			"monitoring_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "OneAgent monitoring of the host is enabled (true) or disabled (false).",
+			},
+			"monitoring_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FULL_STACK",
+				Description:  "The monitoring mode of OneAgent, either FULL_STACK or INFRA_ONLY.",
+				ValidateFunc: validation.StringInSlice([]string{"FULL_STACK", "INFRA_ONLY"}, false),
+			},
+			"auto_injection_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "OneAgent automatically injects into the processes of the host (true) or not (false).",
+			},
+			"auto_update_setting": oneAgentAutoUpdateSettingSchema([]string{"ENABLED", "DISABLED", "INHERITED"}, "INHERITED"),
+			"target_version":      oneAgentTargetVersionSchema(),
+			"update_windows":      oneAgentUpdateWindowsSchema(),
+			"effective_setting": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The auto-update setting in effect on the host, after resolving INHERITED.",
+			},
+			"effective_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OneAgent version in effect on the host, after resolving INHERITED.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceHostMonitoringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	hostID := d.Get("host_id").(string)
+
+	// Every host has a monitoring configuration, creating the resource takes over the configuration with the desired state
+	if diags := updateHostMonitoring(ctx, m, hostID, expandHostMonitoring(d), expandOneAgentAutoUpdate(d)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(hostID)
+
+	return resourceDynatraceHostMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceHostMonitoringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	hostID := d.Id()
+
+	var monitoring hostMonitoring
+	err := dynatraceConfigRestClientV1.get(ctx, "/hosts/"+url.PathEscape(hostID)+"/monitoring", &monitoring)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace host monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	var autoUpdate oneAgentAutoUpdate
+	err = dynatraceConfigRestClientV1.get(ctx, "/hosts/"+url.PathEscape(hostID)+"/autoupdate", &autoUpdate)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace host auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The ID is the host ID, which allows to import the resource
+	d.Set("host_id", hostID)
+	d.Set("monitoring_enabled", monitoring.MonitoringEnabled)
+	d.Set("monitoring_mode", monitoring.MonitoringMode)
+	d.Set("auto_injection_enabled", monitoring.AutoInjectionEnabled)
+
+	if err := setOneAgentAutoUpdate(d, &autoUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOneAgentEffectiveAutoUpdate(d, &autoUpdate)
+
+	return diags
+}
+
+func resourceDynatraceHostMonitoringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("monitoring_enabled", "monitoring_mode", "auto_injection_enabled", "auto_update_setting", "target_version", "update_windows") {
+		if diags := updateHostMonitoring(ctx, m, d.Id(), expandHostMonitoring(d), expandOneAgentAutoUpdate(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceHostMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceHostMonitoringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The configuration can't be deleted, destroying the resource restores the Dynatrace defaults
+	if diags := updateHostMonitoring(ctx, m, d.Id(), defaultHostMonitoring, defaultHostAutoUpdate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func updateHostMonitoring(ctx context.Context, m interface{}, hostID string, monitoring hostMonitoring, autoUpdate oneAgentAutoUpdate) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/hosts/"+url.PathEscape(hostID)+"/monitoring", monitoring, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace host monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = dynatraceConfigRestClientV1.put(ctx, "/hosts/"+url.PathEscape(hostID)+"/autoupdate", autoUpdate, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace host auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandHostMonitoring(d *schema.ResourceData) hostMonitoring {
+	return hostMonitoring{
+		MonitoringEnabled:    d.Get("monitoring_enabled").(bool),
+		MonitoringMode:       d.Get("monitoring_mode").(string),
+		AutoInjectionEnabled: d.Get("auto_injection_enabled").(bool),
+	}
+}
@@ -0,0 +1,521 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// httpMonitor is a synthetic monitor of type HTTP as used by /synthetic/monitors.
+type httpMonitor struct {
+	EntityID             string                     `json:"entityId,omitempty"`
+	Name                 string                     `json:"name"`
+	FrequencyMin         int                        `json:"frequencyMin"`
+	Enabled              bool                       `json:"enabled"`
+	Type                 string                     `json:"type"`
+	CreatedFrom          string                     `json:"createdFrom,omitempty"`
+	Script               httpMonitorScript          `json:"script"`
+	Locations            []string                   `json:"locations"`
+	AnomalyDetection     *syntheticAnomalyDetection `json:"anomalyDetection,omitempty"`
+	Tags                 []syntheticMonitorTag      `json:"tags"`
+	ManuallyAssignedApps []string                   `json:"manuallyAssignedApps"`
+}
+
+type httpMonitorScript struct {
+	Version  string               `json:"version"`
+	Requests []httpMonitorRequest `json:"requests"`
+}
+
+type httpMonitorRequest struct {
+	Description          string                          `json:"description,omitempty"`
+	URL                  string                          `json:"url"`
+	Method               string                          `json:"method"`
+	RequestBody          string                          `json:"requestBody,omitempty"`
+	Validation           *httpMonitorRequestValidation   `json:"validation,omitempty"`
+	Configuration        httpMonitorRequestConfiguration `json:"configuration"`
+	PreProcessingScript  string                          `json:"preProcessingScript,omitempty"`
+	PostProcessingScript string                          `json:"postProcessingScript,omitempty"`
+}
+
+type httpMonitorRequestValidation struct {
+	Rules         []httpMonitorValidationRule `json:"rules"`
+	RulesChaining string                      `json:"rulesChaining,omitempty"`
+}
+
+type httpMonitorValidationRule struct {
+	Type        string `json:"type"`
+	Value       string `json:"value"`
+	PassIfFound bool   `json:"passIfFound"`
+}
+
+type httpMonitorRequestConfiguration struct {
+	AcceptAnyCertificate bool                `json:"acceptAnyCertificate"`
+	FollowRedirects      bool                `json:"followRedirects"`
+	UserAgent            string              `json:"userAgent,omitempty"`
+	RequestHeaders       []httpMonitorHeader `json:"requestHeaders,omitempty"`
+}
+
+type httpMonitorHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// syntheticMonitorID is the response of creating a synthetic monitor or location.
+type syntheticMonitorID struct {
+	EntityID string `json:"entityId"`
+}
+
+func resourceDynatraceHTTPMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceHTTPMonitorCreate,
+		ReadContext:   resourceDynatraceHTTPMonitorRead,
+		UpdateContext: resourceDynatraceHTTPMonitorUpdate,
+		DeleteContext: resourceDynatraceHTTPMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the monitor.",
+			},
+			"frequency": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The frequency of the monitor, in minutes.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 5, 10, 15, 30, 60}),
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitor is enabled (true) or disabled (false).",
+			},
+			"locations": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "A list of locations from which the monitor is executed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"manually_assigned_apps": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of manually assigned applications.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags":              syntheticMonitorTagsSchema(),
+			"anomaly_detection": syntheticAnomalyDetectionSchema(),
+			"request": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The HTTP requests executed by the monitor, in order of execution.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A short description of the event to appear in the web UI.",
+						},
+						"url": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The URL to check.",
+						},
+						"method": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The HTTP method of the request.",
+						},
+						"body": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The body of the HTTP request.",
+						},
+						"accept_any_certificate": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, the monitor accepts any SSL certificate, including invalid and self-signed ones.",
+						},
+						"follow_redirects": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, the monitor follows redirects.",
+						},
+						"user_agent": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The User agent of the request.",
+						},
+						"header": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The HTTP headers of the request.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The key of the header.",
+									},
+									"value": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The value of the header.",
+									},
+								},
+							},
+						},
+						"validation": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Validation helps you verify that your HTTP monitor loads the expected content.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rules_chaining": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "or",
+										Description:  "Whether all rules must pass (and) or at least one rule must pass (or).",
+										ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+									},
+									"rule": &schema.Schema{
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "A list of validation rules.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": &schema.Schema{
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The type of the rule, e.g. patternConstraint, regexConstraint, httpStatusesList or certificateExpiryDateConstraint.",
+												},
+												"value": &schema.Schema{
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The content to look for.",
+												},
+												"pass_if_found": &schema.Schema{
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Default:     false,
+													Description: "The validation condition. true means validation succeeds if the specified content/element is found. false means validation fails if the specified content/element is found.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"pre_processing_script": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Javascript code to execute before sending the request.",
+						},
+						"post_processing_script": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Javascript code to execute after sending the request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceHTTPMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	monitor := expandHTTPMonitor(d)
+	monitor.CreatedFrom = "API"
+
+	var created syntheticMonitorID
+	err := dynatraceEnvRestClientV1.post(ctx, "/synthetic/monitors", monitor, &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace HTTP monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.EntityID)
+
+	resourceDynatraceHTTPMonitorRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceHTTPMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	var monitor httpMonitor
+	err := dynatraceEnvRestClientV1.get(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID), &monitor)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace HTTP monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("request", flattenHTTPMonitorRequests(&monitor.Script.Requests)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tags", flattenSyntheticMonitorTags(&monitor.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("anomaly_detection", flattenSyntheticAnomalyDetection(monitor.AnomalyDetection)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", monitor.Name)
+	d.Set("frequency", monitor.FrequencyMin)
+	d.Set("enabled", monitor.Enabled)
+	d.Set("locations", monitor.Locations)
+	d.Set("manually_assigned_apps", monitor.ManuallyAssignedApps)
+
+	return diags
+}
+
+func resourceDynatraceHTTPMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	if d.HasChanges("name", "frequency", "enabled", "locations", "manually_assigned_apps", "tags", "anomaly_detection", "request") {
+
+		monitor := expandHTTPMonitor(d)
+		monitor.EntityID = monitorID
+
+		err := dynatraceEnvRestClientV1.put(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID), monitor, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace HTTP monitor",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceHTTPMonitorRead(ctx, d, m)
+}
+
+func resourceDynatraceHTTPMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	monitorID := d.Id()
+
+	err := dynatraceEnvRestClientV1.delete(ctx, "/synthetic/monitors/"+url.PathEscape(monitorID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace HTTP monitor",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandHTTPMonitor(d *schema.ResourceData) httpMonitor {
+	return httpMonitor{
+		Name:         d.Get("name").(string),
+		FrequencyMin: d.Get("frequency").(int),
+		Enabled:      d.Get("enabled").(bool),
+		Type:         "HTTP",
+		Script: httpMonitorScript{
+			Version:  "1.0",
+			Requests: expandHTTPMonitorRequests(d.Get("request").([]interface{})),
+		},
+		Locations:            expandStringList(d.Get("locations").(*schema.Set).List()),
+		AnomalyDetection:     expandSyntheticAnomalyDetection(d.Get("anomaly_detection").([]interface{})),
+		Tags:                 expandSyntheticMonitorTags(d.Get("tags").([]interface{})),
+		ManuallyAssignedApps: expandStringList(d.Get("manually_assigned_apps").(*schema.Set).List()),
+	}
+}
+
+func expandHTTPMonitorRequests(requests []interface{}) []httpMonitorRequest {
+	if len(requests) < 1 {
+		return []httpMonitorRequest{}
+	}
+
+	hrs := make([]httpMonitorRequest, len(requests))
+
+	for i, request := range requests {
+
+		m := request.(map[string]interface{})
+
+		hrs[i] = httpMonitorRequest{
+			Description: m["description"].(string),
+			URL:         m["url"].(string),
+			Method:      m["method"].(string),
+			RequestBody: m["body"].(string),
+			Validation:  expandHTTPMonitorRequestValidation(m["validation"].([]interface{})),
+			Configuration: httpMonitorRequestConfiguration{
+				AcceptAnyCertificate: m["accept_any_certificate"].(bool),
+				FollowRedirects:      m["follow_redirects"].(bool),
+				UserAgent:            m["user_agent"].(string),
+				RequestHeaders:       expandHTTPMonitorHeaders(m["header"].([]interface{})),
+			},
+			PreProcessingScript:  m["pre_processing_script"].(string),
+			PostProcessingScript: m["post_processing_script"].(string),
+		}
+	}
+
+	return hrs
+}
+
+func expandHTTPMonitorHeaders(headers []interface{}) []httpMonitorHeader {
+	if len(headers) < 1 {
+		return nil
+	}
+
+	hhs := make([]httpMonitorHeader, len(headers))
+
+	for i, header := range headers {
+
+		m := header.(map[string]interface{})
+
+		hhs[i] = httpMonitorHeader{
+			Name:  m["name"].(string),
+			Value: m["value"].(string),
+		}
+	}
+
+	return hhs
+}
+
+func expandHTTPMonitorRequestValidation(validation []interface{}) *httpMonitorRequestValidation {
+	if len(validation) == 0 || validation[0] == nil {
+		return nil
+	}
+
+	m := validation[0].(map[string]interface{})
+
+	hv := &httpMonitorRequestValidation{
+		RulesChaining: m["rules_chaining"].(string),
+		Rules:         []httpMonitorValidationRule{},
+	}
+
+	for _, rule := range m["rule"].([]interface{}) {
+		r := rule.(map[string]interface{})
+
+		hv.Rules = append(hv.Rules, httpMonitorValidationRule{
+			Type:        r["type"].(string),
+			Value:       r["value"].(string),
+			PassIfFound: r["pass_if_found"].(bool),
+		})
+	}
+
+	return hv
+}
+
+func flattenHTTPMonitorRequests(httpMonitorRequests *[]httpMonitorRequest) []interface{} {
+	if httpMonitorRequests != nil {
+		hrs := make([]interface{}, len(*httpMonitorRequests), len(*httpMonitorRequests))
+
+		for i, httpMonitorRequest := range *httpMonitorRequests {
+			hr := make(map[string]interface{})
+
+			hr["description"] = httpMonitorRequest.Description
+			hr["url"] = httpMonitorRequest.URL
+			hr["method"] = httpMonitorRequest.Method
+			hr["body"] = httpMonitorRequest.RequestBody
+			hr["accept_any_certificate"] = httpMonitorRequest.Configuration.AcceptAnyCertificate
+			hr["follow_redirects"] = httpMonitorRequest.Configuration.FollowRedirects
+			hr["user_agent"] = httpMonitorRequest.Configuration.UserAgent
+			hr["header"] = flattenHTTPMonitorHeaders(&httpMonitorRequest.Configuration.RequestHeaders)
+			hr["validation"] = flattenHTTPMonitorRequestValidation(httpMonitorRequest.Validation)
+			hr["pre_processing_script"] = httpMonitorRequest.PreProcessingScript
+			hr["post_processing_script"] = httpMonitorRequest.PostProcessingScript
+			hrs[i] = hr
+		}
+
+		return hrs
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenHTTPMonitorHeaders(httpMonitorHeaders *[]httpMonitorHeader) []interface{} {
+	if httpMonitorHeaders != nil {
+		hhs := make([]interface{}, len(*httpMonitorHeaders), len(*httpMonitorHeaders))
+
+		for i, httpMonitorHeader := range *httpMonitorHeaders {
+			hh := make(map[string]interface{})
+
+			hh["name"] = httpMonitorHeader.Name
+			hh["value"] = httpMonitorHeader.Value
+			hhs[i] = hh
+		}
+
+		return hhs
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenHTTPMonitorRequestValidation(httpMonitorValidation *httpMonitorRequestValidation) []interface{} {
+	if httpMonitorValidation == nil || len(httpMonitorValidation.Rules) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	rs := make([]interface{}, len(httpMonitorValidation.Rules))
+
+	for i, rule := range httpMonitorValidation.Rules {
+		r := make(map[string]interface{})
+
+		r["type"] = rule.Type
+		r["value"] = rule.Value
+		r["pass_if_found"] = rule.PassIfFound
+		rs[i] = r
+	}
+
+	v := make(map[string]interface{})
+
+	v["rules_chaining"] = httpMonitorValidation.RulesChaining
+	v["rule"] = rs
+
+	return []interface{}{v}
+}
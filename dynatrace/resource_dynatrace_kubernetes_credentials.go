@@ -0,0 +1,275 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// kubernetesCredentials is the request body of /kubernetes/credentials. Unlike KubernetesCredentials of the
+// official client it always sends the flags, which allows to disable them.
+type kubernetesCredentials struct {
+	Id                         string                                     `json:"id,omitempty"`
+	Label                      string                                     `json:"label"`
+	EndpointUrl                string                                     `json:"endpointUrl"`
+	AuthToken                  string                                     `json:"authToken,omitempty"`
+	Active                     bool                                       `json:"active"`
+	EventsIntegrationEnabled   bool                                       `json:"eventsIntegrationEnabled"`
+	WorkloadIntegrationEnabled bool                                       `json:"workloadIntegrationEnabled"`
+	CertificateCheckEnabled    bool                                       `json:"certificateCheckEnabled"`
+	EventsFieldSelectors       []dynatraceConfigV1.KubernetesEventPattern `json:"eventsFieldSelectors"`
+}
+
+func resourceDynatraceKubernetesCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceKubernetesCredentialsCreate,
+		ReadContext:   resourceDynatraceKubernetesCredentialsRead,
+		UpdateContext: resourceDynatraceKubernetesCredentialsUpdate,
+		DeleteContext: resourceDynatraceKubernetesCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Kubernetes credentials.",
+			},
+			"endpoint_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the Kubernetes API server. It must be unique within a Dynatrace environment.",
+			},
+			"auth_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The service account bearer token for the Kubernetes API server. Write-only, it is never read back from Dynatrace.",
+			},
+			"active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitoring is enabled (true) or disabled (false).",
+			},
+			"events_integration_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The monitoring of events is enabled (true) or disabled (false) for the Kubernetes cluster.",
+			},
+			"workload_integration_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Workload and cloud application processing is enabled (true) or disabled (false) for the Kubernetes cluster.",
+			},
+			"certificate_check_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The check of SSL certificates is enabled (true) or disabled (false) for the Kubernetes cluster.",
+			},
+			"events_field_selector": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Kubernetes event filters based on field selectors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The label of the events field selector.",
+						},
+						"field_selector": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The field selector, e.g. involvedObject.kind=Node.",
+						},
+						"active": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Events matching the field selector are fetched (true) or not (false).",
+						},
+					},
+				},
+			},
+			"endpoint_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the configured endpoint, e.g. ASSIGNED or FASTCHECK_AUTH_ERROR.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceKubernetesCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	kubernetesCredentialsBody := dynatraceConfigV1.CreateConfiguration3Opts{
+		KubernetesCredentials: optional.NewInterface(expandKubernetesCredentials(d)),
+	}
+
+	kubernetesCredentials, _, err := dynatraceConfigClientV1.KubernetesCredentialsConfigurationApi.CreateConfiguration3(authConfigV1, &kubernetesCredentialsBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId(kubernetesCredentials.Id)
+
+	resourceDynatraceKubernetesCredentialsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceKubernetesCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	kubernetesCredentialsID := d.Id()
+
+	kubernetesCredentials, _, err := dynatraceConfigClientV1.KubernetesCredentialsConfigurationApi.GetConfiguration8(authConfigV1, kubernetesCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	// The token is never returned, so only a change in the configuration causes a diff
+	d.Set("label", &kubernetesCredentials.Label)
+	d.Set("endpoint_url", &kubernetesCredentials.EndpointUrl)
+	d.Set("active", &kubernetesCredentials.Active)
+	d.Set("events_integration_enabled", &kubernetesCredentials.EventsIntegrationEnabled)
+	d.Set("workload_integration_enabled", &kubernetesCredentials.WorkloadIntegrationEnabled)
+	d.Set("certificate_check_enabled", &kubernetesCredentials.CertificateCheckEnabled)
+	d.Set("endpoint_status", &kubernetesCredentials.EndpointStatus)
+
+	if err := d.Set("events_field_selector", flattenKubernetesEventsFieldSelectors(&kubernetesCredentials.EventsFieldSelectors)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceKubernetesCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	kubernetesCredentialsID := d.Id()
+
+	if d.HasChanges("label", "endpoint_url", "auth_token", "active", "events_integration_enabled", "workload_integration_enabled",
+		"certificate_check_enabled", "events_field_selector") {
+
+		kc := expandKubernetesCredentials(d)
+		kc.Id = kubernetesCredentialsID
+
+		kubernetesCredentialsBody := dynatraceConfigV1.UpdateConfiguration5Opts{
+			KubernetesCredentials: optional.NewInterface(kc),
+		}
+
+		_, _, err := dynatraceConfigClientV1.KubernetesCredentialsConfigurationApi.UpdateConfiguration5(authConfigV1, kubernetesCredentialsID, &kubernetesCredentialsBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceKubernetesCredentialsRead(ctx, d, m)
+}
+
+func resourceDynatraceKubernetesCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	kubernetesCredentialsID := d.Id()
+
+	_, err := dynatraceConfigClientV1.KubernetesCredentialsConfigurationApi.DeleteConfiguration4(authConfigV1, kubernetesCredentialsID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandKubernetesCredentials(d *schema.ResourceData) kubernetesCredentials {
+	kc := kubernetesCredentials{
+		Label:                      d.Get("label").(string),
+		EndpointUrl:                d.Get("endpoint_url").(string),
+		AuthToken:                  d.Get("auth_token").(string),
+		Active:                     d.Get("active").(bool),
+		EventsIntegrationEnabled:   d.Get("events_integration_enabled").(bool),
+		WorkloadIntegrationEnabled: d.Get("workload_integration_enabled").(bool),
+		CertificateCheckEnabled:    d.Get("certificate_check_enabled").(bool),
+		EventsFieldSelectors:       []dynatraceConfigV1.KubernetesEventPattern{},
+	}
+
+	for _, fieldSelector := range d.Get("events_field_selector").([]interface{}) {
+		m := fieldSelector.(map[string]interface{})
+
+		kc.EventsFieldSelectors = append(kc.EventsFieldSelectors, dynatraceConfigV1.KubernetesEventPattern{
+			Label:         m["label"].(string),
+			FieldSelector: m["field_selector"].(string),
+			Active:        m["active"].(bool),
+		})
+	}
+
+	return kc
+}
+
+func flattenKubernetesEventsFieldSelectors(fieldSelectors *[]dynatraceConfigV1.KubernetesEventPattern) []interface{} {
+	if fieldSelectors != nil {
+		fss := make([]interface{}, len(*fieldSelectors), len(*fieldSelectors))
+
+		for i, fieldSelector := range *fieldSelectors {
+			fs := make(map[string]interface{})
+
+			fs["label"] = fieldSelector.Label
+			fs["field_selector"] = fieldSelector.FieldSelector
+			fs["active"] = fieldSelector.Active
+			fss[i] = fs
+		}
+
+		return fss
+	}
+
+	return make([]interface{}, 0)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceDynatraceManagementZones() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceDynatraceManagementZoneCreate,
 		ReadContext:   resourceDynatraceManagementZoneRead,
 		UpdateContext: resourceDynatraceManagementZoneUpdate,
@@ -25,6 +25,11 @@ func resourceDynatraceManagementZones() *schema.Resource {
 				Description: "The name of the management zone.",
 				Required:    true,
 			},
+			"legacy_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Config v1 ID of the management zone, which is the ID expected by other resources referencing the management zone, e.g. the mz_id of alerting profiles.",
+				Computed:    true,
+			},
 			"rule": &schema.Schema{
 				Type:        schema.TypeList,
 				Description: "A list of rules for management zone usage. Each rule is evaluated independently of all other rules.",
@@ -53,62 +58,79 @@ func resourceDynatraceManagementZones() *schema.Resource {
 							Type:        schema.TypeList,
 							Description: "A list of matching rules for the management zone. The management zone applies only if all conditions are fulfilled.",
 							Required:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"key": &schema.Schema{
-										Type:        schema.TypeList,
-										Description: "The key to identify the data we're matching.",
-										Required:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"attribute": &schema.Schema{
-													Type:        schema.TypeString,
-													Description: "The attribute to be used for comparision.",
-													Required:    true,
-												},
-												"type": &schema.Schema{
-													Type:        schema.TypeString,
-													Description: "Defines the actual set of fields depending on the value.",
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"comparison_info": &schema.Schema{
-										Type:        schema.TypeList,
-										Description: "Defines how the matching is actually performed: what and how are we comparing.",
-										Required:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"operator": &schema.Schema{
-													Type:        schema.TypeString,
-													Description: "Operator of the comparison. You can reverse it by setting negate to true. Possible values depend on the type of the comparison. Find the list of actual models in the description of the type field and check the description of the model you need.",
-													Required:    true,
-												},
-												"value": {
-													Type:        schema.TypeMap,
-													Description: "The value to compare to.",
-													Optional:    true,
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-												},
-												"negate": &schema.Schema{
-													Type:        schema.TypeBool,
-													Description: "Reverses the comparison operator. For example it turns the begins with into does not begin with.",
-													Required:    true,
-												},
-												"type": &schema.Schema{
-													Type:        schema.TypeString,
-													Description: "Defines the actual set of fields depending on the value.",
-													Required:    true,
-												},
-											},
-										},
-									},
-								},
+							Elem:        entityRuleEngineConditionResource(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Version 1 identifies the resource by the ID of the settings object if the provider is configured with settings_v2
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resource.CoreConfigSchema().ImpliedType(),
+			Upgrade: managementZoneSettingsV2.upgradeState,
+		},
+	}
+
+	return resource
+}
+
+// entityRuleEngineConditionResource is the condition of the entity rule engine, shared by management zones and naming rules.
+func entityRuleEngineConditionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The key to identify the data we're matching.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The attribute to be used for comparision.",
+							Required:    true,
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Defines the actual set of fields depending on the value.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"comparison_info": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Defines how the matching is actually performed: what and how are we comparing.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Operator of the comparison. You can reverse it by setting negate to true. Possible values depend on the type of the comparison. Find the list of actual models in the description of the type field and check the description of the model you need.",
+							Required:    true,
+						},
+						"value": {
+							Type:        schema.TypeMap,
+							Description: "The value to compare to.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
 							},
 						},
+						"negate": &schema.Schema{
+							Type:        schema.TypeBool,
+							Description: "Reverses the comparison operator. For example it turns the begins with into does not begin with.",
+							Required:    true,
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Defines the actual set of fields depending on the value.",
+							Required:    true,
+						},
 					},
 				},
 			},
@@ -118,6 +140,10 @@ func resourceDynatraceManagementZones() *schema.Resource {
 
 func resourceDynatraceManagementZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return managementZoneSettingsV2.create(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -152,6 +178,10 @@ func resourceDynatraceManagementZoneCreate(ctx context.Context, d *schema.Resour
 
 func resourceDynatraceManagementZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return managementZoneSettingsV2.read(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -175,12 +205,17 @@ func resourceDynatraceManagementZoneRead(ctx context.Context, d *schema.Resource
 	}
 
 	d.Set("name", &managementZone.Name)
+	d.Set("legacy_id", managementZone.Id)
 
 	return diags
 }
 
 func resourceDynatraceManagementZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return managementZoneSettingsV2.update(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -215,6 +250,10 @@ func resourceDynatraceManagementZoneUpdate(ctx context.Context, d *schema.Resour
 
 func resourceDynatraceManagementZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	providerConf := m.(*ProviderConfiguration)
+	if providerConf.SettingsV2 {
+		return managementZoneSettingsV2.delete(ctx, d, m)
+	}
+
 	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
 	authConfigV1 := providerConf.AuthConfigV1
 
@@ -302,8 +341,8 @@ func expandConditionKey(conditionKey []interface{}) dynatraceConfigV1.ConditionK
 		mck.Attribute = attribute.(string)
 	}
 
-	if mkType, ok := m["attribute"]; ok {
-		mck.Attribute = mkType.(string)
+	if mkType, ok := m["type"]; ok {
+		mck.Type = mkType.(string)
 	}
 
 	return mck
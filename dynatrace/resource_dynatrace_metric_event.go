@@ -0,0 +1,615 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// metricEvent is a custom metric event as used by /anomalyDetection/metricEvents. The MetricEvent of the official
+// client neither supports metric selectors and monitoring strategies nor the fields of the alerting scopes and dimensions.
+type metricEvent struct {
+	ID                 string                        `json:"id,omitempty"`
+	MetricID           string                        `json:"metricId,omitempty"`
+	MetricSelector     string                        `json:"metricSelector,omitempty"`
+	Name               string                        `json:"name"`
+	Description        string                        `json:"description"`
+	AggregationType    string                        `json:"aggregationType,omitempty"`
+	Severity           string                        `json:"severity,omitempty"`
+	Enabled            bool                          `json:"enabled"`
+	MonitoringStrategy metricEventMonitoringStrategy `json:"monitoringStrategy"`
+	AlertingScope      []metricEventAlertingScope    `json:"alertingScope,omitempty"`
+	MetricDimensions   []metricEventDimension        `json:"metricDimensions,omitempty"`
+}
+
+// metricEventMonitoringStrategy is either a STATIC_THRESHOLD or an AUTO_ADAPTIVE_BASELINE strategy. The threshold and unit
+// only apply to static thresholds, the number of signal fluctuations only to auto-adaptive baselines.
+type metricEventMonitoringStrategy struct {
+	Type                       string   `json:"type"`
+	AlertCondition             string   `json:"alertCondition"`
+	AlertingOnMissingData      bool     `json:"alertingOnMissingData"`
+	Samples                    int      `json:"samples"`
+	ViolatingSamples           int      `json:"violatingSamples"`
+	DealertingSamples          int      `json:"dealertingSamples"`
+	Threshold                  *float64 `json:"threshold,omitempty"`
+	Unit                       string   `json:"unit,omitempty"`
+	NumberOfSignalFluctuations *float64 `json:"numberOfSignalFluctuations,omitempty"`
+}
+
+// metricEventAlertingScope combines the fields of all alerting scope filter types.
+type metricEventAlertingScope struct {
+	FilterType     string                       `json:"filterType"`
+	EntityID       string                       `json:"entityId,omitempty"`
+	MzID           string                       `json:"mzId,omitempty"`
+	ProcessGroupID string                       `json:"processGroupId,omitempty"`
+	TagFilter      *dynatraceConfigV1.TagFilter `json:"tagFilter,omitempty"`
+	NameFilter     *metricEventTextFilter       `json:"nameFilter,omitempty"`
+}
+
+// metricEventDimension combines the fields of the ENTITY and STRING dimension filter types.
+type metricEventDimension struct {
+	FilterType string                 `json:"filterType"`
+	Key        string                 `json:"key,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	NameFilter *metricEventTextFilter `json:"nameFilter,omitempty"`
+	TextFilter *metricEventTextFilter `json:"textFilter,omitempty"`
+}
+
+type metricEventTextFilter struct {
+	Value    string `json:"value"`
+	Operator string `json:"operator"`
+}
+
+func resourceDynatraceMetricEvent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceMetricEventCreate,
+		ReadContext:   resourceDynatraceMetricEventRead,
+		UpdateContext: resourceDynatraceMetricEventUpdate,
+		DeleteContext: resourceDynatraceMetricEventDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the metric event displayed in the UI.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The description of the metric event. Used as the title of the triggered event.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The metric event is enabled (true) or disabled (false).",
+			},
+			"metric_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID of the metric evaluated by the metric event. Exactly one of metric_id and metric_selector must be set.",
+				ExactlyOneOf: []string{"metric_id", "metric_selector"},
+			},
+			"metric_selector": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The metric selector that should be executed. Exactly one of metric_id and metric_selector must be set.",
+				ExactlyOneOf: []string{"metric_id", "metric_selector"},
+			},
+			"aggregation_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "How the metric data points are aggregated for the evaluation, e.g. AVG, MAX or COUNT. Only applicable to metric_id.",
+				ConflictsWith: []string{"metric_selector"},
+			},
+			"severity": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CUSTOM_ALERT",
+				Description:  "The type of the event to trigger on the threshold violation, either AVAILABILITY, CUSTOM_ALERT, ERROR, INFO, PERFORMANCE or RESOURCE_CONTENTION.",
+				ValidateFunc: validation.StringInSlice([]string{"AVAILABILITY", "CUSTOM_ALERT", "ERROR", "INFO", "PERFORMANCE", "RESOURCE_CONTENTION"}, false),
+			},
+			"monitoring_strategy": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The monitoring strategy of the metric event.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The type of the monitoring strategy, either STATIC_THRESHOLD or AUTO_ADAPTIVE_BASELINE.",
+							ValidateFunc: validation.StringInSlice([]string{"STATIC_THRESHOLD", "AUTO_ADAPTIVE_BASELINE"}, false),
+						},
+						"alert_condition": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The condition for the threshold value check, either ABOVE, BELOW or ABOVE_OR_BELOW. ABOVE_OR_BELOW is only applicable to auto-adaptive baselines.",
+							ValidateFunc: validation.StringInSlice([]string{"ABOVE", "BELOW", "ABOVE_OR_BELOW"}, false),
+						},
+						"alerting_on_missing_data": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Raise an alert if missing data is detected (true) or not (false).",
+						},
+						"samples": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The number of one-minute samples that form the sliding evaluation window.",
+						},
+						"violating_samples": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The number of one-minute samples within the evaluation window that must violate the threshold to trigger an event.",
+						},
+						"dealerting_samples": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The number of one-minute samples within the evaluation window that must go back to normal to close the event.",
+						},
+						"threshold": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The value of the static threshold. Only applicable to STATIC_THRESHOLD.",
+						},
+						"unit": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The unit of the threshold, matching the metric definition. The unit of the metric is used if not set. Only applicable to STATIC_THRESHOLD.",
+						},
+						"number_of_signal_fluctuations": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Default:     1,
+							Description: "How often the signal fluctuation is added to the baseline to calculate the threshold. Only applicable to AUTO_ADAPTIVE_BASELINE.",
+						},
+					},
+				},
+			},
+			"alerting_scope": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The scope of the metric event. Only one filter is allowed per filter type, except for tags, where up to 3 are allowed. The filters are combined by conjunction.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the filter, either ENTITY_ID, MANAGEMENT_ZONE, TAG, NAME, CUSTOM_DEVICE_GROUP_NAME, HOST_GROUP_NAME, HOST_NAME, PROCESS_GROUP_ID or PROCESS_GROUP_NAME.",
+							ValidateFunc: validation.StringInSlice([]string{"ENTITY_ID", "MANAGEMENT_ZONE", "TAG", "NAME", "CUSTOM_DEVICE_GROUP_NAME",
+								"HOST_GROUP_NAME", "HOST_NAME", "PROCESS_GROUP_ID", "PROCESS_GROUP_NAME"}, false),
+						},
+						"entity_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the monitored entity to match on. Only applicable to ENTITY_ID.",
+						},
+						"management_zone_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the management zone to match on. Only applicable to MANAGEMENT_ZONE.",
+						},
+						"process_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the process group to match on. Only applicable to PROCESS_GROUP_ID.",
+						},
+						"tag_filter": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The tag to match on. Only applicable to TAG.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"context": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The origin of the tag, such as AWS or Cloud Foundry. Custom tags use the CONTEXTLESS value.",
+									},
+									"key": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The key of the tag. Custom tags have the tag value here.",
+									},
+									"value": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The value of the tag. Not applicable to custom tags.",
+									},
+								},
+							},
+						},
+						"name_filter": metricEventTextFilterSchema("The name to match on. Only applicable to NAME, CUSTOM_DEVICE_GROUP_NAME, HOST_GROUP_NAME, HOST_NAME and PROCESS_GROUP_NAME."),
+					},
+				},
+			},
+			"metric_dimension": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The dimensions of the metric to alert on. The filters are combined by conjunction.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The type of the dimension, either ENTITY or STRING.",
+							ValidateFunc: validation.StringInSlice([]string{"ENTITY", "STRING"}, false),
+						},
+						"key": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The key of the dimension, e.g. dt.entity.host.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the dimension.",
+						},
+						"filter": metricEventTextFilterSchema("The filter of the dimension. Matches the entity name for ENTITY and the dimension value for STRING."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func metricEventTextFilterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"operator": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The operator to match on, either EQUALS, CONTAINS_CASE_SENSITIVE or CONTAINS_CASE_INSENSITIVE.",
+					ValidateFunc: validation.StringInSlice([]string{"EQUALS", "CONTAINS_CASE_SENSITIVE", "CONTAINS_CASE_INSENSITIVE"}, false),
+				},
+				"value": &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The value to match on.",
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceMetricEventCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	var created entityShortRepresentation
+	err := dynatraceConfigRestClientV1.post(ctx, "/anomalyDetection/metricEvents", expandMetricEvent(d), &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace metric event",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.ID)
+
+	resourceDynatraceMetricEventRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceMetricEventRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	metricEventID := d.Id()
+
+	var event metricEvent
+	err := dynatraceConfigRestClientV1.get(ctx, "/anomalyDetection/metricEvents/"+url.PathEscape(metricEventID), &event)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace metric event",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("name", event.Name)
+	d.Set("description", event.Description)
+	d.Set("enabled", event.Enabled)
+	d.Set("metric_id", event.MetricID)
+	d.Set("metric_selector", event.MetricSelector)
+	d.Set("aggregation_type", event.AggregationType)
+	d.Set("severity", event.Severity)
+
+	if err := d.Set("monitoring_strategy", flattenMetricEventMonitoringStrategy(&event.MonitoringStrategy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("alerting_scope", flattenMetricEventAlertingScopes(&event.AlertingScope)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metric_dimension", flattenMetricEventDimensions(&event.MetricDimensions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceMetricEventUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	metricEventID := d.Id()
+
+	if d.HasChanges("name", "description", "enabled", "metric_id", "metric_selector", "aggregation_type", "severity",
+		"monitoring_strategy", "alerting_scope", "metric_dimension") {
+
+		event := expandMetricEvent(d)
+		event.ID = metricEventID
+
+		err := dynatraceConfigRestClientV1.put(ctx, "/anomalyDetection/metricEvents/"+url.PathEscape(metricEventID), event, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace metric event",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceMetricEventRead(ctx, d, m)
+}
+
+func resourceDynatraceMetricEventDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	metricEventID := d.Id()
+
+	err := dynatraceConfigRestClientV1.delete(ctx, "/anomalyDetection/metricEvents/"+url.PathEscape(metricEventID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace metric event",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandMetricEvent(d *schema.ResourceData) metricEvent {
+	return metricEvent{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Enabled:            d.Get("enabled").(bool),
+		MetricID:           d.Get("metric_id").(string),
+		MetricSelector:     d.Get("metric_selector").(string),
+		AggregationType:    d.Get("aggregation_type").(string),
+		Severity:           d.Get("severity").(string),
+		MonitoringStrategy: expandMetricEventMonitoringStrategy(d.Get("monitoring_strategy").([]interface{})),
+		AlertingScope:      expandMetricEventAlertingScopes(d.Get("alerting_scope").([]interface{})),
+		MetricDimensions:   expandMetricEventDimensions(d.Get("metric_dimension").([]interface{})),
+	}
+}
+
+func expandMetricEventMonitoringStrategy(monitoringStrategy []interface{}) metricEventMonitoringStrategy {
+	if len(monitoringStrategy) == 0 || monitoringStrategy[0] == nil {
+		return metricEventMonitoringStrategy{}
+	}
+
+	m := monitoringStrategy[0].(map[string]interface{})
+
+	ms := metricEventMonitoringStrategy{
+		Type:                  m["type"].(string),
+		AlertCondition:        m["alert_condition"].(string),
+		AlertingOnMissingData: m["alerting_on_missing_data"].(bool),
+		Samples:               m["samples"].(int),
+		ViolatingSamples:      m["violating_samples"].(int),
+		DealertingSamples:     m["dealerting_samples"].(int),
+	}
+
+	switch ms.Type {
+	case "STATIC_THRESHOLD":
+		threshold := m["threshold"].(float64)
+		ms.Threshold = &threshold
+		ms.Unit = m["unit"].(string)
+	case "AUTO_ADAPTIVE_BASELINE":
+		numberOfSignalFluctuations := m["number_of_signal_fluctuations"].(float64)
+		ms.NumberOfSignalFluctuations = &numberOfSignalFluctuations
+	}
+
+	return ms
+}
+
+func expandMetricEventAlertingScopes(alertingScopes []interface{}) []metricEventAlertingScope {
+	if len(alertingScopes) == 0 || alertingScopes[0] == nil {
+		return []metricEventAlertingScope{}
+	}
+
+	ass := make([]metricEventAlertingScope, len(alertingScopes))
+
+	for i, alertingScope := range alertingScopes {
+
+		m := alertingScope.(map[string]interface{})
+
+		ass[i] = metricEventAlertingScope{
+			FilterType:     m["filter_type"].(string),
+			EntityID:       m["entity_id"].(string),
+			MzID:           m["management_zone_id"].(string),
+			ProcessGroupID: m["process_group_id"].(string),
+			NameFilter:     expandMetricEventTextFilter(m["name_filter"].([]interface{})),
+		}
+
+		if tagFilters := expandAlertingProfileTagFilters(m["tag_filter"].([]interface{})); len(tagFilters) != 0 {
+			ass[i].TagFilter = &tagFilters[0]
+		}
+	}
+
+	return ass
+}
+
+func expandMetricEventDimensions(dimensions []interface{}) []metricEventDimension {
+	if len(dimensions) == 0 || dimensions[0] == nil {
+		return []metricEventDimension{}
+	}
+
+	mds := make([]metricEventDimension, len(dimensions))
+
+	for i, dimension := range dimensions {
+
+		m := dimension.(map[string]interface{})
+
+		mds[i] = metricEventDimension{
+			FilterType: m["filter_type"].(string),
+			Key:        m["key"].(string),
+			Name:       m["name"].(string),
+		}
+
+		// Entity dimensions filter by the name of the entity, string dimensions by the text of the dimension
+		switch mds[i].FilterType {
+		case "ENTITY":
+			mds[i].NameFilter = expandMetricEventTextFilter(m["filter"].([]interface{}))
+		case "STRING":
+			mds[i].TextFilter = expandMetricEventTextFilter(m["filter"].([]interface{}))
+		}
+	}
+
+	return mds
+}
+
+func expandMetricEventTextFilter(textFilter []interface{}) *metricEventTextFilter {
+	if len(textFilter) == 0 || textFilter[0] == nil {
+		return nil
+	}
+
+	m := textFilter[0].(map[string]interface{})
+
+	return &metricEventTextFilter{
+		Operator: m["operator"].(string),
+		Value:    m["value"].(string),
+	}
+}
+
+func flattenMetricEventMonitoringStrategy(monitoringStrategy *metricEventMonitoringStrategy) []interface{} {
+	if monitoringStrategy == nil {
+		return []interface{}{monitoringStrategy}
+	}
+
+	ms := make(map[string]interface{})
+
+	ms["type"] = monitoringStrategy.Type
+	ms["alert_condition"] = monitoringStrategy.AlertCondition
+	ms["alerting_on_missing_data"] = monitoringStrategy.AlertingOnMissingData
+	ms["samples"] = monitoringStrategy.Samples
+	ms["violating_samples"] = monitoringStrategy.ViolatingSamples
+	ms["dealerting_samples"] = monitoringStrategy.DealertingSamples
+	ms["unit"] = monitoringStrategy.Unit
+	if monitoringStrategy.Threshold != nil {
+		ms["threshold"] = *monitoringStrategy.Threshold
+	}
+	// Static thresholds have no signal fluctuations, keep the schema default to avoid a diff
+	ms["number_of_signal_fluctuations"] = 1.0
+	if monitoringStrategy.NumberOfSignalFluctuations != nil {
+		ms["number_of_signal_fluctuations"] = *monitoringStrategy.NumberOfSignalFluctuations
+	}
+
+	return []interface{}{ms}
+}
+
+func flattenMetricEventAlertingScopes(alertingScopes *[]metricEventAlertingScope) []interface{} {
+	if alertingScopes != nil {
+		ass := make([]interface{}, len(*alertingScopes), len(*alertingScopes))
+
+		for i, alertingScope := range *alertingScopes {
+			as := make(map[string]interface{})
+
+			as["filter_type"] = alertingScope.FilterType
+			as["entity_id"] = alertingScope.EntityID
+			as["management_zone_id"] = alertingScope.MzID
+			as["process_group_id"] = alertingScope.ProcessGroupID
+			as["name_filter"] = flattenMetricEventTextFilter(alertingScope.NameFilter)
+			as["tag_filter"] = make([]interface{}, 0)
+			if alertingScope.TagFilter != nil {
+				as["tag_filter"] = flattenAlertingProfileTagFilters(&[]dynatraceConfigV1.TagFilter{*alertingScope.TagFilter})
+			}
+			ass[i] = as
+		}
+
+		return ass
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenMetricEventDimensions(dimensions *[]metricEventDimension) []interface{} {
+	if dimensions != nil {
+		mds := make([]interface{}, len(*dimensions), len(*dimensions))
+
+		for i, dimension := range *dimensions {
+			md := make(map[string]interface{})
+
+			md["filter_type"] = dimension.FilterType
+			md["key"] = dimension.Key
+			md["name"] = dimension.Name
+			md["filter"] = make([]interface{}, 0)
+			if dimension.NameFilter != nil {
+				md["filter"] = flattenMetricEventTextFilter(dimension.NameFilter)
+			}
+			if dimension.TextFilter != nil {
+				md["filter"] = flattenMetricEventTextFilter(dimension.TextFilter)
+			}
+			mds[i] = md
+		}
+
+		return mds
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenMetricEventTextFilter(textFilter *metricEventTextFilter) []interface{} {
+	if textFilter == nil {
+		return make([]interface{}, 0)
+	}
+
+	f := make(map[string]interface{})
+
+	f["operator"] = textFilter.Operator
+	f["value"] = textFilter.Value
+
+	return []interface{}{f}
+}
@@ -0,0 +1,387 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mobileApplication is the configuration of a mobile or custom application as used by /applications/mobile.
+type mobileApplication struct {
+	Name                             string                 `json:"name"`
+	ApplicationType                  string                 `json:"applicationType,omitempty"`
+	ApplicationID                    string                 `json:"applicationId,omitempty"`
+	IconType                         string                 `json:"iconType,omitempty"`
+	CostControlUserSessionPercentage int                    `json:"costControlUserSessionPercentage"`
+	ApdexSettings                    mobileApplicationApdex `json:"apdexSettings"`
+	OptInModeEnabled                 bool                   `json:"optInModeEnabled"`
+	SessionReplayEnabled             bool                   `json:"sessionReplayEnabled"`
+	SessionReplayOnCrashEnabled      bool                   `json:"sessionReplayOnCrashEnabled"`
+	BeaconEndpointType               string                 `json:"beaconEndpointType"`
+	BeaconEndpointURL                string                 `json:"beaconEndpointUrl,omitempty"`
+}
+
+// mobileApplicationApdex are the Apdex thresholds of a mobile or custom application in milliseconds.
+type mobileApplicationApdex struct {
+	ToleratedThreshold   int `json:"toleratedThreshold"`
+	FrustratingThreshold int `json:"frustratingThreshold"`
+}
+
+type mobileApplicationKeyUserActions struct {
+	KeyUserActions []struct {
+		Name string `json:"name"`
+	} `json:"keyUserActions"`
+}
+
+func resourceDynatraceMobileApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceMobileApplicationCreate,
+		ReadContext:   resourceDynatraceMobileApplicationRead,
+		UpdateContext: resourceDynatraceMobileApplicationUpdate,
+		DeleteContext: resourceDynatraceMobileApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the application.",
+			},
+			"application_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MOBILE_APPLICATION",
+				ForceNew:     true,
+				Description:  "The type of the application, either MOBILE_APPLICATION or CUSTOM_APPLICATION.",
+				ValidateFunc: validation.StringInSlice([]string{"MOBILE_APPLICATION", "CUSTOM_APPLICATION"}, false),
+			},
+			"application_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The UUID of the application used by the OneAgent SDK. Generated by Dynatrace if not set.",
+			},
+			"icon_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The custom application icon. Only applicable to custom applications.",
+			},
+			"beacon_endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The type of the beacon endpoint, either ENVIRONMENT_ACTIVE_GATE, CLUSTER_ACTIVE_GATE or INSTRUMENTED_WEB_SERVER.",
+				ValidateFunc: validation.StringInSlice([]string{"ENVIRONMENT_ACTIVE_GATE", "CLUSTER_ACTIVE_GATE", "INSTRUMENTED_WEB_SERVER"}, false),
+			},
+			"beacon_endpoint_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the beacon endpoint. Only applicable when beacon_endpoint_type is INSTRUMENTED_WEB_SERVER.",
+			},
+			"cost_control_user_session_percentage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				Description:  "The percentage of user sessions to be analyzed.",
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"apdex_settings": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Apdex configuration of the application.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tolerated_threshold": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Apdex tolerated threshold in milliseconds.",
+						},
+						"frustrating_threshold": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Apdex frustrated threshold in milliseconds.",
+						},
+					},
+				},
+			},
+			"opt_in_mode_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The opt-in mode is enabled (true) or disabled (false).",
+			},
+			"session_replay_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Session replay is enabled (true) or disabled (false).",
+			},
+			"session_replay_on_crash_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Session replay on crash is enabled (true) or disabled (false).",
+			},
+			"key_user_actions": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The names of the user actions to be marked as key user actions.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceMobileApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	app := expandMobileApplication(d)
+
+	var created entityShortRepresentation
+	err := dynatraceConfigRestClientV1.post(ctx, "/applications/mobile", app, &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace mobile application",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.ID)
+
+	if diags := updateMobileApplicationKeyUserActions(ctx, d, m, []interface{}{}, d.Get("key_user_actions").(*schema.Set).List()); diags.HasError() {
+		return diags
+	}
+
+	resourceDynatraceMobileApplicationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceMobileApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	mobileApplicationID := d.Id()
+
+	var app mobileApplication
+	err := dynatraceConfigRestClientV1.get(ctx, "/applications/mobile/"+url.PathEscape(mobileApplicationID), &app)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace mobile application",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	var keyUserActions mobileApplicationKeyUserActions
+	err = dynatraceConfigRestClientV1.get(ctx, "/applications/mobile/"+url.PathEscape(mobileApplicationID)+"/keyUserActions", &keyUserActions)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace mobile application key user actions",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("apdex_settings", flattenMobileApplicationApdex(&app.ApdexSettings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key_user_actions", flattenMobileApplicationKeyUserActions(&keyUserActions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", app.Name)
+	d.Set("application_type", app.ApplicationType)
+	d.Set("application_id", app.ApplicationID)
+	d.Set("icon_type", app.IconType)
+	d.Set("beacon_endpoint_type", app.BeaconEndpointType)
+	d.Set("beacon_endpoint_url", app.BeaconEndpointURL)
+	d.Set("cost_control_user_session_percentage", app.CostControlUserSessionPercentage)
+	d.Set("opt_in_mode_enabled", app.OptInModeEnabled)
+	d.Set("session_replay_enabled", app.SessionReplayEnabled)
+	d.Set("session_replay_on_crash_enabled", app.SessionReplayOnCrashEnabled)
+
+	return diags
+}
+
+func resourceDynatraceMobileApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	mobileApplicationID := d.Id()
+
+	if d.HasChanges("name", "icon_type", "beacon_endpoint_type", "beacon_endpoint_url", "cost_control_user_session_percentage",
+		"apdex_settings", "opt_in_mode_enabled", "session_replay_enabled", "session_replay_on_crash_enabled") {
+
+		app := expandMobileApplication(d)
+
+		err := dynatraceConfigRestClientV1.put(ctx, "/applications/mobile/"+url.PathEscape(mobileApplicationID), app, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace mobile application",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if d.HasChange("key_user_actions") {
+		o, n := d.GetChange("key_user_actions")
+		if diags := updateMobileApplicationKeyUserActions(ctx, d, m, o.(*schema.Set).List(), n.(*schema.Set).List()); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceMobileApplicationRead(ctx, d, m)
+}
+
+func resourceDynatraceMobileApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	mobileApplicationID := d.Id()
+
+	err := dynatraceConfigRestClientV1.delete(ctx, "/applications/mobile/"+url.PathEscape(mobileApplicationID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace mobile application",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// updateMobileApplicationKeyUserActions marks the added user actions as key user actions and unmarks the removed ones.
+func updateMobileApplicationKeyUserActions(ctx context.Context, d *schema.ResourceData, m interface{}, oldActions []interface{}, newActions []interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	path := "/applications/mobile/" + url.PathEscape(d.Id()) + "/keyUserActions/"
+
+	oldSet := schema.NewSet(schema.HashString, oldActions)
+	newSet := schema.NewSet(schema.HashString, newActions)
+
+	for _, action := range oldSet.Difference(newSet).List() {
+		if err := dynatraceConfigRestClientV1.delete(ctx, path+url.PathEscape(action.(string))); err != nil && !isNotFound(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to remove dynatrace mobile application key user action",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	for _, action := range newSet.Difference(oldSet).List() {
+		if err := dynatraceConfigRestClientV1.post(ctx, path+url.PathEscape(action.(string)), nil, nil); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to add dynatrace mobile application key user action",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func expandMobileApplication(d *schema.ResourceData) mobileApplication {
+	return mobileApplication{
+		Name:                             d.Get("name").(string),
+		ApplicationType:                  d.Get("application_type").(string),
+		ApplicationID:                    d.Get("application_id").(string),
+		IconType:                         d.Get("icon_type").(string),
+		CostControlUserSessionPercentage: d.Get("cost_control_user_session_percentage").(int),
+		ApdexSettings:                    expandMobileApplicationApdex(d.Get("apdex_settings").([]interface{})),
+		OptInModeEnabled:                 d.Get("opt_in_mode_enabled").(bool),
+		SessionReplayEnabled:             d.Get("session_replay_enabled").(bool),
+		SessionReplayOnCrashEnabled:      d.Get("session_replay_on_crash_enabled").(bool),
+		BeaconEndpointType:               d.Get("beacon_endpoint_type").(string),
+		BeaconEndpointURL:                d.Get("beacon_endpoint_url").(string),
+	}
+}
+
+func expandMobileApplicationApdex(apdexSettings []interface{}) mobileApplicationApdex {
+	if len(apdexSettings) == 0 || apdexSettings[0] == nil {
+		return mobileApplicationApdex{}
+	}
+
+	m := apdexSettings[0].(map[string]interface{})
+
+	apdex := mobileApplicationApdex{}
+
+	if toleratedThreshold, ok := m["tolerated_threshold"]; ok {
+		apdex.ToleratedThreshold = toleratedThreshold.(int)
+	}
+
+	if frustratingThreshold, ok := m["frustrating_threshold"]; ok {
+		apdex.FrustratingThreshold = frustratingThreshold.(int)
+	}
+
+	return apdex
+
+}
+
+func flattenMobileApplicationApdex(apdexSettings *mobileApplicationApdex) []interface{} {
+	if apdexSettings == nil {
+		return []interface{}{apdexSettings}
+	}
+
+	a := make(map[string]interface{})
+
+	a["tolerated_threshold"] = apdexSettings.ToleratedThreshold
+	a["frustrating_threshold"] = apdexSettings.FrustratingThreshold
+
+	return []interface{}{a}
+}
+
+func flattenMobileApplicationKeyUserActions(keyUserActions *mobileApplicationKeyUserActions) []interface{} {
+	if keyUserActions != nil {
+		kas := make([]interface{}, len(keyUserActions.KeyUserActions), len(keyUserActions.KeyUserActions))
+
+		for i, keyUserAction := range keyUserActions.KeyUserActions {
+			kas[i] = keyUserAction.Name
+		}
+
+		return kas
+	}
+
+	return make([]interface{}, 0)
+}
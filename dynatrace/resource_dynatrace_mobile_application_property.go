@@ -0,0 +1,249 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mobileApplicationProperty is a user action and session property of a mobile or custom application.
+type mobileApplicationProperty struct {
+	Key                        string `json:"key"`
+	DisplayName                string `json:"displayName,omitempty"`
+	Type                       string `json:"type"`
+	Origin                     string `json:"origin"`
+	Aggregation                string `json:"aggregation,omitempty"`
+	StoreAsUserActionProperty  bool   `json:"storeAsUserActionProperty"`
+	StoreAsSessionProperty     bool   `json:"storeAsSessionProperty"`
+	CleanupRule                string `json:"cleanupRule,omitempty"`
+	ServerSideRequestAttribute string `json:"serverSideRequestAttribute,omitempty"`
+	Name                       string `json:"name,omitempty"`
+}
+
+func resourceDynatraceMobileApplicationProperty() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceMobileApplicationPropertyCreate,
+		ReadContext:   resourceDynatraceMobileApplicationPropertyRead,
+		UpdateContext: resourceDynatraceMobileApplicationPropertyUpdate,
+		DeleteContext: resourceDynatraceMobileApplicationPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Dynatrace entity ID of the mobile or custom application.",
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique key of the property.",
+			},
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The display name of the property.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The data type of the property, either DOUBLE, LONG or STRING.",
+				ValidateFunc: validation.StringInSlice([]string{"DOUBLE", "LONG", "STRING"}, false),
+			},
+			"origin": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The origin of the property, either API or SERVER_SIDE_REQUEST_ATTRIBUTE.",
+				ValidateFunc: validation.StringInSlice([]string{"API", "SERVER_SIDE_REQUEST_ATTRIBUTE"}, false),
+			},
+			"aggregation": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The aggregation type of the property, e.g. AVERAGE, FIRST, LAST, MAXIMUM, MINIMUM or SUM.",
+			},
+			"store_as_user_action_property": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the property is stored as a user action property.",
+			},
+			"store_as_session_property": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the property is stored as a session property.",
+			},
+			"cleanup_rule": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The cleanup rule of the property. Defines how to extract the data you need from a string value.",
+			},
+			"server_side_request_attribute": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the request attribute. Only applicable when origin is SERVER_SIDE_REQUEST_ATTRIBUTE.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the reported value. Only applicable when origin is API.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceMobileApplicationPropertyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	applicationID := d.Get("application_id").(string)
+	property := expandMobileApplicationProperty(d)
+
+	err := dynatraceConfigRestClientV1.post(ctx, "/applications/mobile/"+url.PathEscape(applicationID)+"/userActionAndSessionProperties", property, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace mobile application property",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(applicationID + "/" + property.Key)
+
+	resourceDynatraceMobileApplicationPropertyRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceMobileApplicationPropertyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	applicationID, key, err := parseMobileApplicationPropertyID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var property mobileApplicationProperty
+	err = dynatraceConfigRestClientV1.get(ctx, mobileApplicationPropertyPath(applicationID, key), &property)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace mobile application property",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("key", property.Key)
+	d.Set("display_name", property.DisplayName)
+	d.Set("type", property.Type)
+	d.Set("origin", property.Origin)
+	d.Set("aggregation", property.Aggregation)
+	d.Set("store_as_user_action_property", property.StoreAsUserActionProperty)
+	d.Set("store_as_session_property", property.StoreAsSessionProperty)
+	d.Set("cleanup_rule", property.CleanupRule)
+	d.Set("server_side_request_attribute", property.ServerSideRequestAttribute)
+	d.Set("name", property.Name)
+
+	return diags
+}
+
+func resourceDynatraceMobileApplicationPropertyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	applicationID, key, err := parseMobileApplicationPropertyID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = dynatraceConfigRestClientV1.put(ctx, mobileApplicationPropertyPath(applicationID, key), expandMobileApplicationProperty(d), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace mobile application property",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceDynatraceMobileApplicationPropertyRead(ctx, d, m)
+}
+
+func resourceDynatraceMobileApplicationPropertyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	applicationID, key, err := parseMobileApplicationPropertyID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = dynatraceConfigRestClientV1.delete(ctx, mobileApplicationPropertyPath(applicationID, key))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace mobile application property",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func mobileApplicationPropertyPath(applicationID string, key string) string {
+	return "/applications/mobile/" + url.PathEscape(applicationID) + "/userActionAndSessionProperties/" + url.PathEscape(key)
+}
+
+// parseMobileApplicationPropertyID splits an ID of the form <application id>/<property key>.
+func parseMobileApplicationPropertyID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <application id>/<property key>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandMobileApplicationProperty(d *schema.ResourceData) mobileApplicationProperty {
+	return mobileApplicationProperty{
+		Key:                        d.Get("key").(string),
+		DisplayName:                d.Get("display_name").(string),
+		Type:                       d.Get("type").(string),
+		Origin:                     d.Get("origin").(string),
+		Aggregation:                d.Get("aggregation").(string),
+		StoreAsUserActionProperty:  d.Get("store_as_user_action_property").(bool),
+		StoreAsSessionProperty:     d.Get("store_as_session_property").(bool),
+		CleanupRule:                d.Get("cleanup_rule").(string),
+		ServerSideRequestAttribute: d.Get("server_side_request_attribute").(string),
+		Name:                       d.Get("name").(string),
+	}
+}
@@ -0,0 +1,216 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// namingRulePathTypes maps the entity types of naming rules to the type used in the path of /conditionalNaming/{type}.
+var namingRulePathTypes = map[string]string{
+	"HOST":          "host",
+	"PROCESS_GROUP": "processGroup",
+	"SERVICE":       "service",
+}
+
+func resourceDynatraceNamingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceNamingRuleCreate,
+		ReadContext:   resourceDynatraceNamingRuleRead,
+		UpdateContext: resourceDynatraceNamingRuleUpdate,
+		DeleteContext: resourceDynatraceNamingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the naming rule.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of entities to which the naming applies, either HOST, PROCESS_GROUP or SERVICE.",
+				ValidateFunc: validation.StringInSlice([]string{"HOST", "PROCESS_GROUP", "SERVICE"}, false),
+			},
+			"name_format": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The format of the applied name. Can contain placeholders for attributes of entities using the format {Entity:Attribute}, e.g. {ProcessGroup:KubernetesNamespace}.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The naming rule is enabled (true) or disabled (false).",
+			},
+			"condition": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "A list of matching rules for the naming rule. The naming applies only if all conditions are fulfilled.",
+				Required:    true,
+				Elem:        entityRuleEngineConditionResource(),
+			},
+		},
+	}
+}
+
+func resourceDynatraceNamingRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	nr := expandNamingRule(d)
+
+	nrBody := dynatraceConfigV1.CreateNamingRuleOpts{
+		ConditionalNamingRule: optional.NewInterface(nr),
+	}
+
+	namingRule, _, err := dynatraceConfigClientV1.ConditionalNamingApi.CreateNamingRule(authConfigV1, namingRulePathTypes[nr.Type], &nrBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	// The rule can only be read with its type, so the ID contains both
+	d.SetId(nr.Type + "/" + namingRule.Id)
+
+	resourceDynatraceNamingRuleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceNamingRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	ruleType, ruleID, err := parseNamingRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namingRule, _, err := dynatraceConfigClientV1.ConditionalNamingApi.GetSingleNamingRule(authConfigV1, namingRulePathTypes[ruleType], ruleID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	d.Set("name", &namingRule.DisplayName)
+	d.Set("type", &namingRule.Type)
+	d.Set("name_format", &namingRule.NameFormat)
+	d.Set("enabled", &namingRule.Enabled)
+
+	if err := d.Set("condition", flattenManagementZoneConditionsData(&namingRule.Rules)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceNamingRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	ruleType, ruleID, err := parseNamingRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "name_format", "enabled", "condition") {
+
+		nr := expandNamingRule(d)
+		nr.Id = ruleID
+
+		nrBody := dynatraceConfigV1.CreateOrUpdateNamingRuleOpts{
+			ConditionalNamingRule: optional.NewInterface(nr),
+		}
+
+		_, _, err := dynatraceConfigClientV1.ConditionalNamingApi.CreateOrUpdateNamingRule(authConfigV1, namingRulePathTypes[ruleType], ruleID, &nrBody)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create dynatrace client",
+				Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceNamingRuleRead(ctx, d, m)
+}
+
+func resourceDynatraceNamingRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	ruleType, ruleID, err := parseNamingRuleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = dynatraceConfigClientV1.ConditionalNamingApi.DeleteNamingRule(authConfigV1, namingRulePathTypes[ruleType], ruleID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Unable to connect to environment and/or authenticate API token",
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// parseNamingRuleID splits an ID of the form <type>/<naming rule id>.
+func parseNamingRuleID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <type>/<naming rule id>", id)
+	}
+	if _, ok := namingRulePathTypes[parts[0]]; !ok {
+		return "", "", fmt.Errorf("unexpected type of ID (%s), expected HOST, PROCESS_GROUP or SERVICE", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandNamingRule(d *schema.ResourceData) dynatraceConfigV1.ConditionalNamingRule {
+	return dynatraceConfigV1.ConditionalNamingRule{
+		DisplayName: d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		NameFormat:  d.Get("name_format").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Rules:       expandManagementZoneConditions(d.Get("condition").([]interface{})),
+	}
+}
@@ -0,0 +1,177 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// networkZone is a network zone as used by the Environment v2 API /networkZones.
+type networkZone struct {
+	ID               string   `json:"id,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	AlternativeZones []string `json:"alternativeZones"`
+	FallbackMode     string   `json:"fallbackMode,omitempty"`
+}
+
+func resourceDynatraceNetworkZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceNetworkZoneCreate,
+		ReadContext:   resourceDynatraceNetworkZoneRead,
+		UpdateContext: resourceDynatraceNetworkZoneUpdate,
+		DeleteContext: resourceDynatraceNetworkZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the network zone, which is also its ID. Names are case-insensitive and stored in lowercase.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the network zone.",
+			},
+			"alternative_zones": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The alternative network zones, in order of preference, used if no ActiveGate of the network zone is available.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fallback_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ANY_ACTIVE_GATE",
+				Description:  "The fallback mode if neither the network zone nor the alternative zones have an available ActiveGate, either ANY_ACTIVE_GATE, ONLY_DEFAULT_ZONE or NONE.",
+				ValidateFunc: validation.StringInSlice([]string{"ANY_ACTIVE_GATE", "ONLY_DEFAULT_ZONE", "NONE"}, false),
+			},
+		},
+	}
+}
+
+func resourceDynatraceNetworkZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The network zone is created with the same PUT as it is updated, its ID is the name
+	zoneID := strings.ToLower(d.Get("name").(string))
+	if diags := updateNetworkZone(ctx, m, zoneID, expandNetworkZone(d)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(zoneID)
+
+	return resourceDynatraceNetworkZoneRead(ctx, d, m)
+}
+
+func resourceDynatraceNetworkZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "networkZones.read"); diags.HasError() {
+		return diags
+	}
+
+	zoneID := d.Id()
+
+	var zone networkZone
+	err := dynatraceEnvRestClientV2.get(ctx, "/networkZones/"+url.PathEscape(zoneID), &zone)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace network zone",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The ID is the name of the network zone, which allows to import the resource
+	d.Set("name", zone.ID)
+	d.Set("description", zone.Description)
+	d.Set("alternative_zones", zone.AlternativeZones)
+	d.Set("fallback_mode", zone.FallbackMode)
+
+	return diags
+}
+
+func resourceDynatraceNetworkZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("description", "alternative_zones", "fallback_mode") {
+		if diags := updateNetworkZone(ctx, m, d.Id(), expandNetworkZone(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceNetworkZoneRead(ctx, d, m)
+}
+
+func resourceDynatraceNetworkZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "networkZones.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.delete(ctx, "/networkZones/"+url.PathEscape(d.Id()))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace network zone",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func updateNetworkZone(ctx context.Context, m interface{}, zoneID string, zone networkZone) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "networkZones.read", "networkZones.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.put(ctx, "/networkZones/"+url.PathEscape(zoneID), zone, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace network zone",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandNetworkZone(d *schema.ResourceData) networkZone {
+	return networkZone{
+		Description:      d.Get("description").(string),
+		AlternativeZones: expandStringList(d.Get("alternative_zones").([]interface{})),
+		FallbackMode:     d.Get("fallback_mode").(string),
+	}
+}
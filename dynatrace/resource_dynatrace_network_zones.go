@@ -0,0 +1,120 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// networkZonesID is the fixed ID of the network zones singleton.
+const networkZonesID = "network_zones"
+
+// networkZoneSettings is the environment-wide network zone setting as used by the Environment v2 API /networkZoneSettings.
+type networkZoneSettings struct {
+	NetworkZonesEnabled bool `json:"networkZonesEnabled"`
+}
+
+// defaultNetworkZoneSettings are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultNetworkZoneSettings = networkZoneSettings{}
+
+func resourceDynatraceNetworkZones() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceNetworkZonesCreate,
+		ReadContext:   resourceDynatraceNetworkZonesRead,
+		UpdateContext: resourceDynatraceNetworkZonesUpdate,
+		DeleteContext: resourceDynatraceNetworkZonesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Network zones are enabled (true) or disabled (false) in the environment.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceNetworkZonesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, networkZonesID, func() diag.Diagnostics {
+		return updateNetworkZoneSettings(ctx, m, expandNetworkZoneSettings(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceNetworkZonesRead(ctx, d, m)
+}
+
+func resourceDynatraceNetworkZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "networkZones.read"); diags.HasError() {
+		return diags
+	}
+
+	var settings networkZoneSettings
+	err := dynatraceEnvRestClientV2.get(ctx, "/networkZoneSettings", &settings)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace network zone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("enabled", settings.NetworkZonesEnabled)
+
+	return diags
+}
+
+func resourceDynatraceNetworkZonesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		if diags := updateNetworkZoneSettings(ctx, m, expandNetworkZoneSettings(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceNetworkZonesRead(ctx, d, m)
+}
+
+func resourceDynatraceNetworkZonesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateNetworkZoneSettings(ctx, m, defaultNetworkZoneSettings)
+	})
+}
+
+func updateNetworkZoneSettings(ctx context.Context, m interface{}, settings networkZoneSettings) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "networkZones.read", "networkZones.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.put(ctx, "/networkZoneSettings", settings, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace network zone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandNetworkZoneSettings(d *schema.ResourceData) networkZoneSettings {
+	return networkZoneSettings{
+		NetworkZonesEnabled: d.Get("enabled").(bool),
+	}
+}
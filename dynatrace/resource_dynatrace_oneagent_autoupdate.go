@@ -0,0 +1,107 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// oneAgentAutoUpdateID is the fixed ID of the environment-wide OneAgent auto-update singleton.
+const oneAgentAutoUpdateID = "oneagent_autoupdate"
+
+// defaultEnvironmentAutoUpdate are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultEnvironmentAutoUpdate = oneAgentAutoUpdate{
+	Setting: "ENABLED",
+	UpdateWindows: oneAgentUpdateWindows{
+		Windows: []oneAgentUpdateWindow{},
+	},
+}
+
+func resourceDynatraceOneAgentAutoUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceOneAgentAutoUpdateCreate,
+		ReadContext:   resourceDynatraceOneAgentAutoUpdateRead,
+		UpdateContext: resourceDynatraceOneAgentAutoUpdateUpdate,
+		DeleteContext: resourceDynatraceOneAgentAutoUpdateDelete,
+		CustomizeDiff: validateOneAgentAutoUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_update_setting": oneAgentAutoUpdateSettingSchema([]string{"ENABLED", "DISABLED"}, "ENABLED"),
+			"target_version":      oneAgentTargetVersionSchema(),
+			"update_windows":      oneAgentUpdateWindowsSchema(),
+		},
+	}
+}
+
+func resourceDynatraceOneAgentAutoUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, oneAgentAutoUpdateID, func() diag.Diagnostics {
+		return updateEnvironmentAutoUpdate(ctx, m, expandOneAgentAutoUpdate(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceOneAgentAutoUpdateRead(ctx, d, m)
+}
+
+func resourceDynatraceOneAgentAutoUpdateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	var autoUpdate oneAgentAutoUpdate
+	err := dynatraceConfigRestClientV1.get(ctx, "/hosts/autoupdate", &autoUpdate)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace OneAgent auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := setOneAgentAutoUpdate(d, &autoUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceOneAgentAutoUpdateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("auto_update_setting", "target_version", "update_windows") {
+		if diags := updateEnvironmentAutoUpdate(ctx, m, expandOneAgentAutoUpdate(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceOneAgentAutoUpdateRead(ctx, d, m)
+}
+
+func resourceDynatraceOneAgentAutoUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateEnvironmentAutoUpdate(ctx, m, defaultEnvironmentAutoUpdate)
+	})
+}
+
+func updateEnvironmentAutoUpdate(ctx context.Context, m interface{}, autoUpdate oneAgentAutoUpdate) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/hosts/autoupdate", autoUpdate, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace OneAgent auto-update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,201 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// processGroupAnomalyDetection is the anomaly detection of a process group as used by /anomalyDetection/processGroups/{id}.
+type processGroupAnomalyDetection struct {
+	AvailabilityMonitoring processGroupAvailabilityMonitoring `json:"availabilityMonitoring"`
+}
+
+type processGroupAvailabilityMonitoring struct {
+	Method           string `json:"method"`
+	MinimumThreshold int    `json:"minimumThreshold,omitempty"`
+}
+
+func resourceDynatraceProcessGroupAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceProcessGroupAnomaliesCreate,
+		ReadContext:   resourceDynatraceProcessGroupAnomaliesRead,
+		UpdateContext: resourceDynatraceProcessGroupAnomaliesUpdate,
+		DeleteContext: resourceDynatraceProcessGroupAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"process_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the process group, e.g. PROCESS_GROUP-1234567890ABCDEF.",
+			},
+			"availability_monitoring": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration of the availability monitoring of the process group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "How to monitor the availability of the process group: PROCESS_IMPACT (alert if any process of the group becomes unavailable), MINIMUM_THRESHOLD (alert if the number of active processes in the group falls below the minimum_threshold) or OFF.",
+							ValidateFunc: validation.StringInSlice([]string{"PROCESS_IMPACT", "MINIMUM_THRESHOLD", "OFF"}, false),
+						},
+						"minimum_threshold": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Alert if the number of active processes in the group is lower than X. Only applicable to MINIMUM_THRESHOLD.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceProcessGroupAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	processGroupID := d.Get("process_group_id").(string)
+
+	// Every process group has an anomaly detection, creating the resource overwrites it
+	err := dynatraceConfigRestClientV1.put(ctx, "/anomalyDetection/processGroups/"+url.PathEscape(processGroupID), expandProcessGroupAnomalyDetection(d), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace process group anomaly detection",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(processGroupID)
+
+	resourceDynatraceProcessGroupAnomaliesRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceProcessGroupAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	processGroupID := d.Id()
+
+	var processGroupAnomalies processGroupAnomalyDetection
+	err := dynatraceConfigRestClientV1.get(ctx, "/anomalyDetection/processGroups/"+url.PathEscape(processGroupID), &processGroupAnomalies)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace process group anomaly detection",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The ID is the process group ID, which allows to import the resource
+	d.Set("process_group_id", processGroupID)
+
+	if err := d.Set("availability_monitoring", flattenProcessGroupAvailabilityMonitoring(&processGroupAnomalies.AvailabilityMonitoring)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceProcessGroupAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	processGroupID := d.Id()
+
+	if d.HasChange("availability_monitoring") {
+
+		err := dynatraceConfigRestClientV1.put(ctx, "/anomalyDetection/processGroups/"+url.PathEscape(processGroupID), expandProcessGroupAnomalyDetection(d), nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace process group anomaly detection",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceProcessGroupAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceProcessGroupAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	processGroupID := d.Id()
+
+	// Deleting the anomaly detection of a process group restores the default settings
+	err := dynatraceConfigRestClientV1.delete(ctx, "/anomalyDetection/processGroups/"+url.PathEscape(processGroupID))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace process group anomaly detection",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandProcessGroupAnomalyDetection(d *schema.ResourceData) processGroupAnomalyDetection {
+	var processGroupAnomalies processGroupAnomalyDetection
+
+	if v := d.Get("availability_monitoring").([]interface{}); len(v) != 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		processGroupAnomalies.AvailabilityMonitoring = processGroupAvailabilityMonitoring{
+			Method: m["method"].(string),
+		}
+		if processGroupAnomalies.AvailabilityMonitoring.Method == "MINIMUM_THRESHOLD" {
+			processGroupAnomalies.AvailabilityMonitoring.MinimumThreshold = m["minimum_threshold"].(int)
+		}
+	}
+
+	return processGroupAnomalies
+}
+
+func flattenProcessGroupAvailabilityMonitoring(availabilityMonitoring *processGroupAvailabilityMonitoring) []interface{} {
+	if availabilityMonitoring == nil {
+		return []interface{}{availabilityMonitoring}
+	}
+
+	a := make(map[string]interface{})
+
+	a["method"] = availabilityMonitoring.Method
+	a["minimum_threshold"] = availabilityMonitoring.MinimumThreshold
+
+	return []interface{}{a}
+}
@@ -0,0 +1,132 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// processGroupDeepMonitoring is the deep monitoring rule of a process group as used by /processGroups/{id}/deepMonitoring.
+type processGroupDeepMonitoring struct {
+	MonitoringState string `json:"monitoringState"`
+}
+
+func resourceDynatraceProcessGroupDeepMonitoring() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceProcessGroupDeepMonitoringCreate,
+		ReadContext:   resourceDynatraceProcessGroupDeepMonitoringRead,
+		UpdateContext: resourceDynatraceProcessGroupDeepMonitoringUpdate,
+		DeleteContext: resourceDynatraceProcessGroupDeepMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"process_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the process group, e.g. PROCESS_GROUP-1234567890ABCDEF.",
+			},
+			"monitoring_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The deep monitoring of the process group: MONITORING_ON, MONITORING_OFF or DEFAULT, which follows the technology monitoring of the environment.",
+				ValidateFunc: validation.StringInSlice([]string{"MONITORING_ON", "MONITORING_OFF", "DEFAULT"}, false),
+			},
+		},
+	}
+}
+
+func resourceDynatraceProcessGroupDeepMonitoringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	processGroupID := d.Get("process_group_id").(string)
+
+	// Every process group has a deep monitoring rule, creating the resource overwrites it
+	if diags := updateProcessGroupDeepMonitoring(ctx, m, processGroupID, expandProcessGroupDeepMonitoring(d)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(processGroupID)
+
+	return resourceDynatraceProcessGroupDeepMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceProcessGroupDeepMonitoringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	processGroupID := d.Id()
+
+	var deepMonitoring processGroupDeepMonitoring
+	err := dynatraceConfigRestClientV1.get(ctx, "/processGroups/"+url.PathEscape(processGroupID)+"/deepMonitoring", &deepMonitoring)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace process group deep monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// The ID is the process group ID, which allows to import the resource
+	d.Set("process_group_id", processGroupID)
+	d.Set("monitoring_state", deepMonitoring.MonitoringState)
+
+	return diags
+}
+
+func resourceDynatraceProcessGroupDeepMonitoringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("monitoring_state") {
+		if diags := updateProcessGroupDeepMonitoring(ctx, m, d.Id(), expandProcessGroupDeepMonitoring(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceProcessGroupDeepMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceProcessGroupDeepMonitoringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The rule can't be deleted, destroying the resource restores the DEFAULT state
+	diags := updateProcessGroupDeepMonitoring(ctx, m, d.Id(), processGroupDeepMonitoring{MonitoringState: "DEFAULT"})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func updateProcessGroupDeepMonitoring(ctx context.Context, m interface{}, processGroupID string, deepMonitoring processGroupDeepMonitoring) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/processGroups/"+url.PathEscape(processGroupID)+"/deepMonitoring", deepMonitoring, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace process group deep monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandProcessGroupDeepMonitoring(d *schema.ResourceData) processGroupDeepMonitoring {
+	return processGroupDeepMonitoring{
+		MonitoringState: d.Get("monitoring_state").(string),
+	}
+}
@@ -0,0 +1,145 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// serviceAnomaliesID is the fixed ID of the service anomaly detection singleton.
+const serviceAnomaliesID = "service_anomalies"
+
+// serviceAnomalyDetection is the request body of /anomalyDetection/services. Unlike ServiceAnomalyDetectionConfig
+// of the official client it omits the automatic detection and threshold settings which do not apply to the detection mode.
+type serviceAnomalyDetection struct {
+	ResponseTimeDegradation responseTimeDegradationDetection            `json:"responseTimeDegradation"`
+	LoadDrop                *dynatraceConfigV1.LoadDropDetectionConfig  `json:"loadDrop,omitempty"`
+	LoadSpike               *dynatraceConfigV1.LoadSpikeDetectionConfig `json:"loadSpike,omitempty"`
+	FailureRateIncrease     failureRateIncreaseDetection                `json:"failureRateIncrease"`
+}
+
+// defaultServiceAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultServiceAnomalyDetection = serviceAnomalyDetection{
+	ResponseTimeDegradation: defaultResponseTimeDegradationDetection,
+	LoadDrop:                &dynatraceConfigV1.LoadDropDetectionConfig{Enabled: false},
+	LoadSpike:               &dynatraceConfigV1.LoadSpikeDetectionConfig{Enabled: false},
+	FailureRateIncrease:     defaultFailureRateIncreaseDetection,
+}
+
+func resourceDynatraceServiceAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceServiceAnomaliesCreate,
+		ReadContext:   resourceDynatraceServiceAnomaliesRead,
+		UpdateContext: resourceDynatraceServiceAnomaliesUpdate,
+		DeleteContext: resourceDynatraceServiceAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"response_time_degradation": responseTimeDegradationSchema(),
+			"failure_rate_increase":     failureRateIncreaseSchema(),
+			"load_drop":                 loadDropSchema(),
+			"load_spike":                loadSpikeSchema(),
+		},
+	}
+}
+
+func resourceDynatraceServiceAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, serviceAnomaliesID, func() diag.Diagnostics {
+		return updateServiceAnomalyDetection(m, expandServiceAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceServiceAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceServiceAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	serviceAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionServicesApi.GetConfiguration2(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	if err := d.Set("response_time_degradation", flattenResponseTimeDegradation(&serviceAnomalies.ResponseTimeDegradation)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("failure_rate_increase", flattenFailureRateIncrease(&serviceAnomalies.FailureRateIncrease)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("load_drop", flattenLoadDrop(&serviceAnomalies.LoadDrop, d.Get("load_drop").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("load_spike", flattenLoadSpike(&serviceAnomalies.LoadSpike, d.Get("load_spike").([]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceServiceAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("response_time_degradation", "failure_rate_increase", "load_drop", "load_spike") {
+		if diags := updateServiceAnomalyDetection(m, expandServiceAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceServiceAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceServiceAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateServiceAnomalyDetection(m, defaultServiceAnomalyDetection)
+	})
+}
+
+func updateServiceAnomalyDetection(m interface{}, serviceAnomalies serviceAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	serviceAnomaliesBody := dynatraceConfigV1.UpdateConfiguration2Opts{
+		ServiceAnomalyDetectionConfig: optional.NewInterface(serviceAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionServicesApi.UpdateConfiguration2(authConfigV1, &serviceAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandServiceAnomalyDetection(d *schema.ResourceData) serviceAnomalyDetection {
+	return serviceAnomalyDetection{
+		ResponseTimeDegradation: expandResponseTimeDegradation(d.Get("response_time_degradation").([]interface{})),
+		FailureRateIncrease:     expandFailureRateIncrease(d.Get("failure_rate_increase").([]interface{})),
+		LoadDrop:                expandLoadDrop(d.Get("load_drop").([]interface{})),
+		LoadSpike:               expandLoadSpike(d.Get("load_spike").([]interface{})),
+	}
+}
@@ -0,0 +1,312 @@
+package dynatrace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDynatraceSettingsObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceSettingsObjectCreate,
+		ReadContext:   resourceDynatraceSettingsObjectRead,
+		UpdateContext: resourceDynatraceSettingsObjectUpdate,
+		DeleteContext: resourceDynatraceSettingsObjectDelete,
+		CustomizeDiff: resourceDynatraceSettingsObjectCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the settings schema, e.g. builtin:alerting.profile.",
+			},
+			"schema_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the settings schema. If not set, the latest version is used.",
+			},
+			"scope": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "environment",
+				ForceNew:    true,
+				Description: "The scope of the settings object, either environment or the ID of an entity, e.g. HOST-1234567890.",
+			},
+			"value": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The value of the settings object as JSON object, e.g. encoded with jsonencode.",
+				ValidateFunc: validateSettingsValue,
+				StateFunc: func(v interface{}) string {
+					normalized, err := normalizeSettingsValue(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return normalized
+				},
+			},
+			"insert_after": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the settings object after which this object is placed. Only applicable to schemas with ordered objects. If not set, new objects are placed last and the position isn't managed.",
+			},
+		},
+	}
+}
+
+func resourceDynatraceSettingsObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	object := settingsObjectCreate{
+		SchemaID:      d.Get("schema_id").(string),
+		SchemaVersion: d.Get("schema_version").(string),
+		Scope:         d.Get("scope").(string),
+		Value:         expandSettingsValue(d),
+	}
+	if insertAfter, ok := d.GetOk("insert_after"); ok {
+		insertAfter := insertAfter.(string)
+		object.InsertAfter = &insertAfter
+	}
+
+	objectID, err := createSettingsObject(ctx, dynatraceEnvRestClientV2, object)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace settings object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(objectID)
+
+	return resourceDynatraceSettingsObjectRead(ctx, d, m)
+}
+
+func resourceDynatraceSettingsObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read"); diags.HasError() {
+		return diags
+	}
+
+	objectID := d.Id()
+
+	var object settingsObject
+	err := dynatraceEnvRestClientV2.get(ctx, "/settings/objects/"+url.PathEscape(objectID), &object)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace settings object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	settingsSchema, err := getSettingsSchema(ctx, m, object.SchemaID, object.SchemaVersion)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to read dynatrace settings schema %s", object.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	value, err := flattenSettingsValue(settingsSchema, object.Value, d.Get("value").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace settings object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("schema_id", object.SchemaID)
+	d.Set("schema_version", object.SchemaVersion)
+	d.Set("scope", object.Scope)
+	d.Set("value", value)
+
+	// The position is only managed if configured, the object before this one is the current position
+	if d.Get("insert_after").(string) != "" {
+		objectIDs, err := listSettingsObjectIDs(ctx, dynatraceEnvRestClientV2, object.SchemaID, object.Scope)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read dynatrace settings objects order",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		insertAfter := ""
+		for i, id := range objectIDs {
+			if id == objectID && i > 0 {
+				insertAfter = objectIDs[i-1]
+			}
+		}
+		d.Set("insert_after", insertAfter)
+	}
+
+	return diags
+}
+
+func resourceDynatraceSettingsObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	if d.HasChanges("schema_version", "value", "insert_after") {
+		update := settingsObjectUpdate{
+			SchemaVersion: d.Get("schema_version").(string),
+			Value:         expandSettingsValue(d),
+		}
+		if insertAfter := d.Get("insert_after").(string); d.HasChange("insert_after") && insertAfter != "" {
+			update.InsertAfter = &insertAfter
+		}
+
+		err := dynatraceEnvRestClientV2.put(ctx, "/settings/objects/"+url.PathEscape(d.Id()), update, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace settings object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceSettingsObjectRead(ctx, d, m)
+}
+
+func resourceDynatraceSettingsObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.delete(ctx, "/settings/objects/"+url.PathEscape(d.Id()))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace settings object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDynatraceSettingsObjectCustomizeDiff validates the value against the settings schema at plan time,
+// so invalid values are reported before any settings object is changed.
+func resourceDynatraceSettingsObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("schema_id") || !d.NewValueKnown("value") {
+		return nil
+	}
+
+	// Without a configured version, e.g. on create, the schema_version is unknown and the latest version is used
+	schemaVersion := ""
+	if d.NewValueKnown("schema_version") {
+		schemaVersion = d.Get("schema_version").(string)
+	}
+
+	schemaID := d.Get("schema_id").(string)
+	settingsSchema, err := getSettingsSchema(ctx, m, schemaID, schemaVersion)
+	if err != nil {
+		return fmt.Errorf("unable to read dynatrace settings schema %s: %s", schemaID, err.Error())
+	}
+
+	if d.Get("insert_after").(string) != "" && !settingsSchema.Ordered {
+		return fmt.Errorf("insert_after is only applicable to ordered settings schemas, %s isn't ordered", schemaID)
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("value").(string)), &value); err != nil {
+		return err
+	}
+
+	if errs := settingsSchema.validate(value); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return fmt.Errorf("value doesn't match the settings schema %s %s:\n%s", schemaID, settingsSchema.Version, strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+func validateSettingsValue(v interface{}, k string) ([]string, []error) {
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &value); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON object: %s", k, err.Error())}
+	}
+
+	return nil, nil
+}
+
+func expandSettingsValue(d *schema.ResourceData) map[string]interface{} {
+	var value map[string]interface{}
+	json.Unmarshal([]byte(d.Get("value").(string)), &value)
+
+	return value
+}
+
+// flattenSettingsValue encodes the value returned by the API, reduced to the properties of the configured value.
+// Secrets are masked by the API, they keep their configured value.
+func flattenSettingsValue(settingsSchema *settingsSchema, remote map[string]interface{}, configured string) (string, error) {
+	var projected interface{} = remote
+	if configured != "" {
+		var configuredValue map[string]interface{}
+		if err := json.Unmarshal([]byte(configured), &configuredValue); err != nil {
+			return "", err
+		}
+		settingsSchema.keepSecrets(remote, configuredValue)
+		projected = projectSettingsValue(remote, configuredValue)
+	}
+
+	value, err := json.Marshal(projected)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,324 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// slo is a service-level objective as used by the Environment v2 API /slo.
+type slo struct {
+	ID                  string                  `json:"id,omitempty"`
+	Name                string                  `json:"name"`
+	Description         string                  `json:"description,omitempty"`
+	Enabled             bool                    `json:"enabled"`
+	MetricName          string                  `json:"metricName,omitempty"`
+	MetricExpression    string                  `json:"metricExpression,omitempty"`
+	UseRateMetric       *bool                   `json:"useRateMetric,omitempty"`
+	MetricNumerator     string                  `json:"metricNumerator,omitempty"`
+	MetricDenominator   string                  `json:"metricDenominator,omitempty"`
+	Filter              string                  `json:"filter,omitempty"`
+	Target              float64                 `json:"target"`
+	Warning             float64                 `json:"warning"`
+	Timeframe           string                  `json:"timeframe"`
+	EvaluationType      string                  `json:"evaluationType"`
+	ErrorBudgetBurnRate *sloErrorBudgetBurnRate `json:"errorBudgetBurnRate,omitempty"`
+}
+
+type sloErrorBudgetBurnRate struct {
+	BurnRateVisualizationEnabled bool    `json:"burnRateVisualizationEnabled"`
+	FastBurnThreshold            float64 `json:"fastBurnThreshold,omitempty"`
+}
+
+func resourceDynatraceSLO() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceSLOCreate,
+		ReadContext:   resourceDynatraceSLORead,
+		UpdateContext: resourceDynatraceSLOUpdate,
+		DeleteContext: resourceDynatraceSLODelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the SLO.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the SLO.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The SLO is enabled (true) or disabled (false).",
+			},
+			"metric_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the metrics the SLO is exposed as, e.g. func:slo.<metric_name>. If not set, it is derived from the name.",
+			},
+			"metric_expression": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The percentage-based metric expression of the SLO, e.g. (100)*(builtin:service.errors.server.successCount:splitBy())/(builtin:service.requestCount.server:splitBy()).",
+				ExactlyOneOf: []string{"metric_expression", "metric_numerator"},
+			},
+			"metric_numerator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The metric selector of the good events, divided by metric_denominator to calculate the SLO.",
+				RequiredWith: []string{"metric_denominator"},
+			},
+			"metric_denominator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The metric selector of all events, dividing metric_numerator to calculate the SLO.",
+				RequiredWith: []string{"metric_numerator"},
+			},
+			"filter": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The entity selector to filter the entities the SLO is calculated for, e.g. type(\"SERVICE\"),tag(\"production\").",
+			},
+			"target": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Required:     true,
+				Description:  "The target value of the SLO in percent.",
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			"warning": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Required:     true,
+				Description:  "The warning value of the SLO in percent. At warning state the SLO is still fulfilled but is getting close to failure.",
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			"timeframe": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The timeframe of the SLO evaluation, e.g. -1d, -1w or -1M.",
+			},
+			"evaluation_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AGGREGATE",
+				Description:  "The evaluation type of the SLO, only AGGREGATE is supported.",
+				ValidateFunc: validation.StringInSlice([]string{"AGGREGATE"}, false),
+			},
+			"error_budget_burn_rate": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Configuration of the error budget burn rate of the SLO.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burn_rate_visualization_enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "The error budget burn rate is calculated and shown (true) or not (false).",
+						},
+						"fast_burn_threshold": &schema.Schema{
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      10,
+							Description:  "The threshold above which the error budget is considered to burn fast.",
+							ValidateFunc: validation.FloatAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDynatraceSLOCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "slo.read", "slo.write"); diags.HasError() {
+		return diags
+	}
+
+	sloID, err := dynatraceEnvRestClientV2.postLocation(ctx, "/slo", expandSLO(d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace SLO",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(sloID)
+
+	resourceDynatraceSLORead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceSLORead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "slo.read"); diags.HasError() {
+		return diags
+	}
+
+	sloID := d.Id()
+
+	var objective slo
+	err := dynatraceEnvRestClientV2.get(ctx, "/slo/"+url.PathEscape(sloID), &objective)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace SLO",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("name", objective.Name)
+	d.Set("description", objective.Description)
+	d.Set("enabled", objective.Enabled)
+	d.Set("metric_name", objective.MetricName)
+	d.Set("filter", objective.Filter)
+	d.Set("target", objective.Target)
+	d.Set("warning", objective.Warning)
+	d.Set("timeframe", objective.Timeframe)
+	d.Set("evaluation_type", objective.EvaluationType)
+
+	// The API returns the numerator and denominator of an expression based SLO too, only the configured variant is kept
+	if _, ok := d.GetOk("metric_numerator"); ok {
+		d.Set("metric_numerator", objective.MetricNumerator)
+		d.Set("metric_denominator", objective.MetricDenominator)
+	} else {
+		d.Set("metric_expression", objective.MetricExpression)
+	}
+
+	if err := d.Set("error_budget_burn_rate", flattenSLOErrorBudgetBurnRate(objective.ErrorBudgetBurnRate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynatraceSLOUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "slo.read", "slo.write"); diags.HasError() {
+		return diags
+	}
+
+	sloID := d.Id()
+
+	if d.HasChanges("name", "description", "enabled", "metric_name", "metric_expression", "metric_numerator", "metric_denominator",
+		"filter", "target", "warning", "timeframe", "evaluation_type", "error_budget_burn_rate") {
+
+		err := dynatraceEnvRestClientV2.put(ctx, "/slo/"+url.PathEscape(sloID), expandSLO(d), nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace SLO",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceSLORead(ctx, d, m)
+}
+
+func resourceDynatraceSLODelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "slo.write"); diags.HasError() {
+		return diags
+	}
+
+	sloID := d.Id()
+
+	err := dynatraceEnvRestClientV2.delete(ctx, "/slo/"+url.PathEscape(sloID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace SLO",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandSLO(d *schema.ResourceData) slo {
+	objective := slo{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Enabled:          d.Get("enabled").(bool),
+		MetricName:       d.Get("metric_name").(string),
+		MetricExpression: d.Get("metric_expression").(string),
+		Filter:           d.Get("filter").(string),
+		Target:           d.Get("target").(float64),
+		Warning:          d.Get("warning").(float64),
+		Timeframe:        d.Get("timeframe").(string),
+		EvaluationType:   d.Get("evaluation_type").(string),
+	}
+
+	if numerator := d.Get("metric_numerator").(string); numerator != "" {
+		useRateMetric := false
+		objective.UseRateMetric = &useRateMetric
+		objective.MetricNumerator = numerator
+		objective.MetricDenominator = d.Get("metric_denominator").(string)
+	}
+
+	if v := d.Get("error_budget_burn_rate").([]interface{}); len(v) != 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		objective.ErrorBudgetBurnRate = &sloErrorBudgetBurnRate{
+			BurnRateVisualizationEnabled: m["burn_rate_visualization_enabled"].(bool),
+			FastBurnThreshold:            m["fast_burn_threshold"].(float64),
+		}
+	}
+
+	return objective
+}
+
+func flattenSLOErrorBudgetBurnRate(errorBudgetBurnRate *sloErrorBudgetBurnRate) []interface{} {
+	if errorBudgetBurnRate == nil {
+		return make([]interface{}, 0)
+	}
+
+	e := make(map[string]interface{})
+
+	e["burn_rate_visualization_enabled"] = errorBudgetBurnRate.BurnRateVisualizationEnabled
+	e["fast_burn_threshold"] = errorBudgetBurnRate.FastBurnThreshold
+
+	return []interface{}{e}
+}
@@ -0,0 +1,257 @@
+package dynatrace
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// syntheticLocation is a synthetic location as used by /synthetic/locations. Only private locations can be managed.
+type syntheticLocation struct {
+	EntityID                         string   `json:"entityId,omitempty"`
+	Type                             string   `json:"type"`
+	Name                             string   `json:"name"`
+	CountryCode                      string   `json:"countryCode,omitempty"`
+	RegionCode                       string   `json:"regionCode,omitempty"`
+	City                             string   `json:"city,omitempty"`
+	Latitude                         float64  `json:"latitude"`
+	Longitude                        float64  `json:"longitude"`
+	Status                           string   `json:"status,omitempty"`
+	Nodes                            []string `json:"nodes,omitempty"`
+	CloudPlatform                    string   `json:"cloudPlatform,omitempty"`
+	Ips                              []string `json:"ips,omitempty"`
+	Stage                            string   `json:"stage,omitempty"`
+	AvailabilityLocationOutage       bool     `json:"availabilityLocationOutage"`
+	AvailabilityNodeOutage           bool     `json:"availabilityNodeOutage"`
+	LocationNodeOutageDelayInMinutes int      `json:"locationNodeOutageDelayInMinutes,omitempty"`
+	AvailabilityNotificationsEnabled bool     `json:"availabilityNotificationsEnabled"`
+	AutoUpdateChromium               bool     `json:"autoUpdateChromium"`
+}
+
+func resourceDynatraceSyntheticLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceSyntheticLocationCreate,
+		ReadContext:   resourceDynatraceSyntheticLocationRead,
+		UpdateContext: resourceDynatraceSyntheticLocationUpdate,
+		DeleteContext: resourceDynatraceSyntheticLocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the private location.",
+			},
+			"country_code": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The country code of the location, e.g. AT.",
+			},
+			"region_code": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region code of the location.",
+			},
+			"city": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The city of the location.",
+			},
+			"latitude": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Required:     true,
+				Description:  "The latitude of the location in DDD.dddd format.",
+				ValidateFunc: validation.FloatBetween(-90, 90),
+			},
+			"longitude": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Required:     true,
+				Description:  "The longitude of the location in DDD.dddd format.",
+				ValidateFunc: validation.FloatBetween(-180, 180),
+			},
+			"nodes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The IDs of the synthetic-enabled ActiveGates (nodes) assigned to the location.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"availability_location_outage": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Alert if the location or all its nodes are unavailable (true) or not (false).",
+			},
+			"availability_node_outage": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Alert if any node of the location is unavailable (true) or not (false).",
+			},
+			"location_node_outage_delay_in_minutes": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				Description:  "Alert if the location or a node is unavailable for longer than X minutes.",
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"availability_notifications_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Notifications for location and node outage are enabled (true) or disabled (false).",
+			},
+			"auto_update_chromium": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Chromium is updated automatically on the nodes of the location (true) or not (false).",
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				Description:  "The status of the location, either ENABLED, DISABLED or HIDDEN.",
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED", "HIDDEN"}, false),
+			},
+		},
+	}
+}
+
+func resourceDynatraceSyntheticLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	var created syntheticMonitorID
+	err := dynatraceEnvRestClientV1.post(ctx, "/synthetic/locations", expandSyntheticLocation(d), &created)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace synthetic location",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(created.EntityID)
+
+	resourceDynatraceSyntheticLocationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDynatraceSyntheticLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	locationID := d.Id()
+
+	var location syntheticLocation
+	err := dynatraceEnvRestClientV1.get(ctx, "/synthetic/locations/"+url.PathEscape(locationID), &location)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace synthetic location",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.Set("name", location.Name)
+	d.Set("country_code", location.CountryCode)
+	d.Set("region_code", location.RegionCode)
+	d.Set("city", location.City)
+	d.Set("latitude", location.Latitude)
+	d.Set("longitude", location.Longitude)
+	d.Set("nodes", location.Nodes)
+	d.Set("availability_location_outage", location.AvailabilityLocationOutage)
+	d.Set("availability_node_outage", location.AvailabilityNodeOutage)
+	d.Set("location_node_outage_delay_in_minutes", location.LocationNodeOutageDelayInMinutes)
+	d.Set("availability_notifications_enabled", location.AvailabilityNotificationsEnabled)
+	d.Set("auto_update_chromium", location.AutoUpdateChromium)
+	d.Set("status", location.Status)
+
+	return diags
+}
+
+func resourceDynatraceSyntheticLocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	locationID := d.Id()
+
+	if d.HasChanges("name", "country_code", "region_code", "city", "latitude", "longitude", "nodes", "availability_location_outage",
+		"availability_node_outage", "location_node_outage_delay_in_minutes", "availability_notifications_enabled", "auto_update_chromium", "status") {
+
+		err := dynatraceEnvRestClientV1.put(ctx, "/synthetic/locations/"+url.PathEscape(locationID), expandSyntheticLocation(d), nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update dynatrace synthetic location",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynatraceSyntheticLocationRead(ctx, d, m)
+}
+
+func resourceDynatraceSyntheticLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV1 := providerConf.DynatraceEnvRestClientV1
+
+	var diags diag.Diagnostics
+
+	locationID := d.Id()
+
+	err := dynatraceEnvRestClientV1.delete(ctx, "/synthetic/locations/"+url.PathEscape(locationID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete dynatrace synthetic location",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandSyntheticLocation(d *schema.ResourceData) syntheticLocation {
+	return syntheticLocation{
+		Type:                             "PRIVATE",
+		Name:                             d.Get("name").(string),
+		CountryCode:                      d.Get("country_code").(string),
+		RegionCode:                       d.Get("region_code").(string),
+		City:                             d.Get("city").(string),
+		Latitude:                         d.Get("latitude").(float64),
+		Longitude:                        d.Get("longitude").(float64),
+		Nodes:                            expandStringList(d.Get("nodes").(*schema.Set).List()),
+		AvailabilityLocationOutage:       d.Get("availability_location_outage").(bool),
+		AvailabilityNodeOutage:           d.Get("availability_node_outage").(bool),
+		LocationNodeOutageDelayInMinutes: d.Get("location_node_outage_delay_in_minutes").(int),
+		AvailabilityNotificationsEnabled: d.Get("availability_notifications_enabled").(bool),
+		AutoUpdateChromium:               d.Get("auto_update_chromium").(bool),
+		Status:                           d.Get("status").(string),
+	}
+}
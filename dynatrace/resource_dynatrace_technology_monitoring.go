@@ -0,0 +1,162 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// technologyMonitoringID is the fixed ID of the technology monitoring singleton.
+const technologyMonitoringID = "technology_monitoring"
+
+// monitoredTechnologies maps the arguments of the resource to the technology types of /technologies.
+var monitoredTechnologies = map[string]string{
+	"java":    "JAVA",
+	"dotnet":  "DOTNET",
+	"nodejs":  "NODE_JS",
+	"php":     "PHP",
+	"go":      "GO",
+	"python":  "PYTHON",
+	"ruby":    "RUBY",
+	"nginx":   "NGINX",
+	"apache":  "APACHE",
+	"iis":     "IIS",
+	"varnish": "VARNISH",
+	"envoy":   "ENVOY",
+}
+
+// technologyMonitoring is the environment-wide technology monitoring as used by /technologies.
+type technologyMonitoring struct {
+	Technologies []monitoredTechnology `json:"technologies"`
+}
+
+type monitoredTechnology struct {
+	Type              string `json:"type"`
+	MonitoringEnabled bool   `json:"monitoringEnabled"`
+}
+
+func resourceDynatraceTechnologyMonitoring() *schema.Resource {
+	technologySchema := make(map[string]*schema.Schema)
+	for argument, technology := range monitoredTechnologies {
+		technologySchema[argument] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Deep monitoring of " + technology + " processes is enabled (true) or disabled (false).",
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceDynatraceTechnologyMonitoringCreate,
+		ReadContext:   resourceDynatraceTechnologyMonitoringRead,
+		UpdateContext: resourceDynatraceTechnologyMonitoringUpdate,
+		DeleteContext: resourceDynatraceTechnologyMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: technologySchema,
+	}
+}
+
+func resourceDynatraceTechnologyMonitoringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, technologyMonitoringID, func() diag.Diagnostics {
+		return updateTechnologyMonitoring(ctx, m, expandTechnologyMonitoring(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceTechnologyMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceTechnologyMonitoringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	var technologies technologyMonitoring
+	err := dynatraceConfigRestClientV1.get(ctx, "/technologies", &technologies)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read dynatrace technology monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	enabled := make(map[string]bool)
+	for _, technology := range technologies.Technologies {
+		enabled[technology.Type] = technology.MonitoringEnabled
+	}
+
+	// Technologies not returned by the environment keep their configured value
+	for argument, technology := range monitoredTechnologies {
+		if monitoringEnabled, ok := enabled[technology]; ok {
+			d.Set(argument, monitoringEnabled)
+		}
+	}
+
+	return diags
+}
+
+func resourceDynatraceTechnologyMonitoringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	arguments := make([]string, 0, len(monitoredTechnologies))
+	for argument := range monitoredTechnologies {
+		arguments = append(arguments, argument)
+	}
+
+	if d.HasChanges(arguments...) {
+		if diags := updateTechnologyMonitoring(ctx, m, expandTechnologyMonitoring(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceTechnologyMonitoringRead(ctx, d, m)
+}
+
+func resourceDynatraceTechnologyMonitoringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// By default all technologies are monitored
+	technologies := technologyMonitoring{}
+	for _, technology := range monitoredTechnologies {
+		technologies.Technologies = append(technologies.Technologies, monitoredTechnology{Type: technology, MonitoringEnabled: true})
+	}
+
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateTechnologyMonitoring(ctx, m, technologies)
+	})
+}
+
+func updateTechnologyMonitoring(ctx context.Context, m interface{}, technologies technologyMonitoring) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var diags diag.Diagnostics
+
+	err := dynatraceConfigRestClientV1.put(ctx, "/technologies", technologies, nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to update dynatrace technology monitoring",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandTechnologyMonitoring(d *schema.ResourceData) technologyMonitoring {
+	technologies := technologyMonitoring{}
+
+	for argument, technology := range monitoredTechnologies {
+		technologies.Technologies = append(technologies.Technologies, monitoredTechnology{
+			Type:              technology,
+			MonitoringEnabled: d.Get(argument).(bool),
+		})
+	}
+
+	return technologies
+}
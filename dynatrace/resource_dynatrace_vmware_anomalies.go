@@ -0,0 +1,367 @@
+package dynatrace
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dynatraceConfigV1 "github.com/dynatrace-ace/dynatrace-go-api-client/api/v1/config/dynatrace"
+)
+
+// vmwareAnomaliesID is the fixed ID of the VMware anomaly detection singleton.
+const vmwareAnomaliesID = "vmware_anomalies"
+
+// vmwareAnomalyDetection is the request body of /anomalyDetection/vmware.
+type vmwareAnomalyDetection struct {
+	EsxiHighCpuSaturation        customThresholdDetection `json:"esxiHighCpuSaturation"`
+	GuestCpuLimitReached         customThresholdDetection `json:"guestCpuLimitReached"`
+	EsxiHighMemoryDetection      customThresholdDetection `json:"esxiHighMemoryDetection"`
+	OverloadedStorageDetection   customThresholdDetection `json:"overloadedStorageDetection"`
+	UndersizedStorageDetection   customThresholdDetection `json:"undersizedStorageDetection"`
+	SlowPhysicalStorageDetection customThresholdDetection `json:"slowPhysicalStorageDetection"`
+	DroppedPacketsDetection      customThresholdDetection `json:"droppedPacketsDetection"`
+	LowDatastoreSpaceDetection   customThresholdDetection `json:"lowDatastoreSpaceDetection"`
+}
+
+// defaultVMwareAnomalyDetection are the Dynatrace default settings, restored when the resource is destroyed.
+var defaultVMwareAnomalyDetection = vmwareAnomalyDetection{
+	EsxiHighCpuSaturation:        customThresholdDetection{Enabled: true},
+	GuestCpuLimitReached:         customThresholdDetection{Enabled: true},
+	EsxiHighMemoryDetection:      customThresholdDetection{Enabled: true},
+	OverloadedStorageDetection:   customThresholdDetection{Enabled: true},
+	UndersizedStorageDetection:   customThresholdDetection{Enabled: true},
+	SlowPhysicalStorageDetection: customThresholdDetection{Enabled: true},
+	DroppedPacketsDetection:      customThresholdDetection{Enabled: true},
+	LowDatastoreSpaceDetection:   customThresholdDetection{Enabled: true},
+}
+
+func resourceDynatraceVMwareAnomalies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDynatraceVMwareAnomaliesCreate,
+		ReadContext:   resourceDynatraceVMwareAnomaliesRead,
+		UpdateContext: resourceDynatraceVMwareAnomaliesUpdate,
+		DeleteContext: resourceDynatraceVMwareAnomaliesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"esxi_high_cpu_saturation": customThresholdDetectionSchema("Configuration of ESXi host CPU saturation detection.", map[string]*schema.Schema{
+				"cpu_usage_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if CPU usage is higher than X % in 3 out of 5 samples.",
+				},
+				"vm_cpu_ready_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if VM CPU ready is higher than X % in 3 out of 5 samples.",
+				},
+				"cpu_peak_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if at least one peak higher than X % occurred in 3 out of 5 samples.",
+				},
+			}),
+			"guest_cpu_limit_reached": customThresholdDetectionSchema("Configuration of guest CPU limit reached detection.", map[string]*schema.Schema{
+				"host_cpu_usage_min_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the hypervisor CPU usage is higher than X % in 3 out of 5 samples.",
+				},
+				"vm_cpu_usage_max_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the VM CPU usage (VM CPU usage Mhz / VM CPU limit in Mhz) is higher than X % in 3 out of 5 samples.",
+				},
+				"vm_cpu_ready_max_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if VM CPU ready is higher than X % in 3 out of 5 samples.",
+				},
+			}),
+			"esxi_high_memory_detection": customThresholdDetectionSchema("Configuration of ESXi host memory saturation detection.", map[string]*schema.Schema{
+				"compression_decompression_rate": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "Alert if the ESXi host swap IN/OUT or compression/decompression rate is higher than X kilobytes per second in 3 out of 5 samples.",
+				},
+			}),
+			"overloaded_storage_detection": customThresholdDetectionSchema("Configuration of overloaded storage detection.", map[string]*schema.Schema{
+				"command_aborts_number": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the number of command aborts is higher than X in 3 out of 5 samples.",
+				},
+			}),
+			"undersized_storage_detection": customThresholdDetectionSchema("Configuration of undersized storage device detection.", map[string]*schema.Schema{
+				"average_queue_command_latency": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the average queue command latency is higher than X milliseconds in 3 out of 5 samples.",
+				},
+				"peak_queue_command_latency": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the peak queue command latency is higher than X milliseconds in 3 out of 5 samples.",
+				},
+			}),
+			"slow_physical_storage_detection": customThresholdDetectionSchema("Configuration of slow running physical storage device detection.", map[string]*schema.Schema{
+				"avg_read_write_latency": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the read/write latency is higher than X milliseconds in 4 out of 5 samples.",
+				},
+				"peak_read_write_latency": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the peak value for read/write latency is higher than X milliseconds in 4 out of 5 samples.",
+				},
+			}),
+			"dropped_packets_detection": customThresholdDetectionSchema("Configuration of high number of dropped packets detection.", map[string]*schema.Schema{
+				"dropped_packets_per_second": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the receive/transmit dropped packets rate on NIC is higher than X packets per second in 3 out of 5 samples.",
+				},
+			}),
+			"low_datastore_space_detection": customThresholdDetectionSchema("Configuration of low datastore free space detection.", map[string]*schema.Schema{
+				"free_space_percentage": &schema.Schema{
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Alert if the datastore free space is lower than X %.",
+				},
+			}),
+		},
+	}
+}
+
+func resourceDynatraceVMwareAnomaliesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := createSingletonSettings(d, vmwareAnomaliesID, func() diag.Diagnostics {
+		return updateVMwareAnomalyDetection(m, expandVMwareAnomalyDetection(d))
+	}); diags.HasError() {
+		return diags
+	}
+
+	return resourceDynatraceVMwareAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceVMwareAnomaliesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	vmwareAnomalies, _, err := dynatraceConfigClientV1.AnomalyDetectionVMwareApi.GetVMwareAnomalyDetectionConfig(authConfigV1)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	for key, detection := range flattenVMwareAnomalyDetection(&vmwareAnomalies) {
+		if err := d.Set(key, detection); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDynatraceVMwareAnomaliesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("esxi_high_cpu_saturation", "guest_cpu_limit_reached", "esxi_high_memory_detection", "overloaded_storage_detection", "undersized_storage_detection", "slow_physical_storage_detection", "dropped_packets_detection", "low_datastore_space_detection") {
+		if diags := updateVMwareAnomalyDetection(m, expandVMwareAnomalyDetection(d)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDynatraceVMwareAnomaliesRead(ctx, d, m)
+}
+
+func resourceDynatraceVMwareAnomaliesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deleteSingletonSettings(d, func() diag.Diagnostics {
+		return updateVMwareAnomalyDetection(m, defaultVMwareAnomalyDetection)
+	})
+}
+
+func updateVMwareAnomalyDetection(m interface{}, vmwareAnomalies vmwareAnomalyDetection) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigClientV1 := providerConf.DynatraceConfigClientV1
+	authConfigV1 := providerConf.AuthConfigV1
+
+	var diags diag.Diagnostics
+
+	vmwareAnomaliesBody := dynatraceConfigV1.UpdateVMwareAnomalyDetectionConfigOpts{
+		VMwareAnomalyDetectionConfig: optional.NewInterface(vmwareAnomalies),
+	}
+
+	_, err := dynatraceConfigClientV1.AnomalyDetectionVMwareApi.UpdateVMwareAnomalyDetectionConfig(authConfigV1, &vmwareAnomaliesBody)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create dynatrace client",
+			Detail:   "Bad Request or unable to connect to environment/authenticate API token",
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func expandVMwareAnomalyDetection(d *schema.ResourceData) vmwareAnomalyDetection {
+	var vmwareAnomalies vmwareAnomalyDetection
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("esxi_high_cpu_saturation").([]interface{})); t != nil {
+		vmwareAnomalies.EsxiHighCpuSaturation = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.EsxiHighCpuThresholds{
+			CpuUsagePercentage:   int32(t["cpu_usage_percentage"].(int)),
+			VmCpuReadyPercentage: int32(t["vm_cpu_ready_percentage"].(int)),
+			CpuPeakPercentage:    int32(t["cpu_peak_percentage"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.EsxiHighCpuSaturation = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("guest_cpu_limit_reached").([]interface{})); t != nil {
+		vmwareAnomalies.GuestCpuLimitReached = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.GuestCpuLimitThresholds{
+			HostCpuUsageMinPercentage: int32(t["host_cpu_usage_min_percentage"].(int)),
+			VmCpuUsageMaxPercentage:   int32(t["vm_cpu_usage_max_percentage"].(int)),
+			VmCpuReadyMaxPercentage:   int32(t["vm_cpu_ready_max_percentage"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.GuestCpuLimitReached = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("esxi_high_memory_detection").([]interface{})); t != nil {
+		vmwareAnomalies.EsxiHighMemoryDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.EsxiHighMemoryThresholds{
+			CompressionDecompressionRate: float32(t["compression_decompression_rate"].(float64)),
+		}}
+	} else {
+		vmwareAnomalies.EsxiHighMemoryDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("overloaded_storage_detection").([]interface{})); t != nil {
+		vmwareAnomalies.OverloadedStorageDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.OverloadedStorageThresholds{
+			CommandAbortsNumber: int32(t["command_aborts_number"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.OverloadedStorageDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("undersized_storage_detection").([]interface{})); t != nil {
+		vmwareAnomalies.UndersizedStorageDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.UndersizedStorageThresholds{
+			AverageQueueCommandLatency: int32(t["average_queue_command_latency"].(int)),
+			PeakQueueCommandLatency:    int32(t["peak_queue_command_latency"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.UndersizedStorageDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("slow_physical_storage_detection").([]interface{})); t != nil {
+		vmwareAnomalies.SlowPhysicalStorageDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.SlowPhysicalStorageThresholds{
+			AvgReadWriteLatency:  int32(t["avg_read_write_latency"].(int)),
+			PeakReadWriteLatency: int32(t["peak_read_write_latency"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.SlowPhysicalStorageDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("dropped_packets_detection").([]interface{})); t != nil {
+		vmwareAnomalies.DroppedPacketsDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.DroppedPacketsThresholds{
+			DroppedPacketsPerSecond: int32(t["dropped_packets_per_second"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.DroppedPacketsDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	if enabled, t := expandCustomThresholdDetection(d.Get("low_datastore_space_detection").([]interface{})); t != nil {
+		vmwareAnomalies.LowDatastoreSpaceDetection = customThresholdDetection{Enabled: enabled, CustomThresholds: &dynatraceConfigV1.LowDatastoreSpaceThresholds{
+			FreeSpacePercentage: int32(t["free_space_percentage"].(int)),
+		}}
+	} else {
+		vmwareAnomalies.LowDatastoreSpaceDetection = customThresholdDetection{Enabled: enabled}
+	}
+
+	return vmwareAnomalies
+}
+
+func flattenVMwareAnomalyDetection(vmwareAnomalies *dynatraceConfigV1.VMwareAnomalyDetectionConfig) map[string][]interface{} {
+	a := make(map[string][]interface{})
+
+	var esxiHighCpuSaturation map[string]interface{}
+	if (dynatraceConfigV1.EsxiHighCpuThresholds{}) != vmwareAnomalies.EsxiHighCpuSaturation.CustomThresholds {
+		esxiHighCpuSaturation = map[string]interface{}{
+			"cpu_usage_percentage":    vmwareAnomalies.EsxiHighCpuSaturation.CustomThresholds.CpuUsagePercentage,
+			"vm_cpu_ready_percentage": vmwareAnomalies.EsxiHighCpuSaturation.CustomThresholds.VmCpuReadyPercentage,
+			"cpu_peak_percentage":     vmwareAnomalies.EsxiHighCpuSaturation.CustomThresholds.CpuPeakPercentage,
+		}
+	}
+	a["esxi_high_cpu_saturation"] = flattenCustomThresholdDetection(vmwareAnomalies.EsxiHighCpuSaturation.Enabled, esxiHighCpuSaturation)
+
+	var guestCpuLimitReached map[string]interface{}
+	if (dynatraceConfigV1.GuestCpuLimitThresholds{}) != vmwareAnomalies.GuestCpuLimitReached.CustomThresholds {
+		guestCpuLimitReached = map[string]interface{}{
+			"host_cpu_usage_min_percentage": vmwareAnomalies.GuestCpuLimitReached.CustomThresholds.HostCpuUsageMinPercentage,
+			"vm_cpu_usage_max_percentage":   vmwareAnomalies.GuestCpuLimitReached.CustomThresholds.VmCpuUsageMaxPercentage,
+			"vm_cpu_ready_max_percentage":   vmwareAnomalies.GuestCpuLimitReached.CustomThresholds.VmCpuReadyMaxPercentage,
+		}
+	}
+	a["guest_cpu_limit_reached"] = flattenCustomThresholdDetection(vmwareAnomalies.GuestCpuLimitReached.Enabled, guestCpuLimitReached)
+
+	var esxiHighMemoryDetection map[string]interface{}
+	if (dynatraceConfigV1.EsxiHighMemoryThresholds{}) != vmwareAnomalies.EsxiHighMemoryDetection.CustomThresholds {
+		esxiHighMemoryDetection = map[string]interface{}{
+			"compression_decompression_rate": float64(vmwareAnomalies.EsxiHighMemoryDetection.CustomThresholds.CompressionDecompressionRate),
+		}
+	}
+	a["esxi_high_memory_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.EsxiHighMemoryDetection.Enabled, esxiHighMemoryDetection)
+
+	var overloadedStorageDetection map[string]interface{}
+	if (dynatraceConfigV1.OverloadedStorageThresholds{}) != vmwareAnomalies.OverloadedStorageDetection.CustomThresholds {
+		overloadedStorageDetection = map[string]interface{}{
+			"command_aborts_number": vmwareAnomalies.OverloadedStorageDetection.CustomThresholds.CommandAbortsNumber,
+		}
+	}
+	a["overloaded_storage_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.OverloadedStorageDetection.Enabled, overloadedStorageDetection)
+
+	var undersizedStorageDetection map[string]interface{}
+	if (dynatraceConfigV1.UndersizedStorageThresholds{}) != vmwareAnomalies.UndersizedStorageDetection.CustomThresholds {
+		undersizedStorageDetection = map[string]interface{}{
+			"average_queue_command_latency": vmwareAnomalies.UndersizedStorageDetection.CustomThresholds.AverageQueueCommandLatency,
+			"peak_queue_command_latency":    vmwareAnomalies.UndersizedStorageDetection.CustomThresholds.PeakQueueCommandLatency,
+		}
+	}
+	a["undersized_storage_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.UndersizedStorageDetection.Enabled, undersizedStorageDetection)
+
+	var slowPhysicalStorageDetection map[string]interface{}
+	if (dynatraceConfigV1.SlowPhysicalStorageThresholds{}) != vmwareAnomalies.SlowPhysicalStorageDetection.CustomThresholds {
+		slowPhysicalStorageDetection = map[string]interface{}{
+			"avg_read_write_latency":  vmwareAnomalies.SlowPhysicalStorageDetection.CustomThresholds.AvgReadWriteLatency,
+			"peak_read_write_latency": vmwareAnomalies.SlowPhysicalStorageDetection.CustomThresholds.PeakReadWriteLatency,
+		}
+	}
+	a["slow_physical_storage_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.SlowPhysicalStorageDetection.Enabled, slowPhysicalStorageDetection)
+
+	var droppedPacketsDetection map[string]interface{}
+	if (dynatraceConfigV1.DroppedPacketsThresholds{}) != vmwareAnomalies.DroppedPacketsDetection.CustomThresholds {
+		droppedPacketsDetection = map[string]interface{}{
+			"dropped_packets_per_second": vmwareAnomalies.DroppedPacketsDetection.CustomThresholds.DroppedPacketsPerSecond,
+		}
+	}
+	a["dropped_packets_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.DroppedPacketsDetection.Enabled, droppedPacketsDetection)
+
+	var lowDatastoreSpaceDetection map[string]interface{}
+	if (dynatraceConfigV1.LowDatastoreSpaceThresholds{}) != vmwareAnomalies.LowDatastoreSpaceDetection.CustomThresholds {
+		lowDatastoreSpaceDetection = map[string]interface{}{
+			"free_space_percentage": vmwareAnomalies.LowDatastoreSpaceDetection.CustomThresholds.FreeSpacePercentage,
+		}
+	}
+	a["low_datastore_space_detection"] = flattenCustomThresholdDetection(vmwareAnomalies.LowDatastoreSpaceDetection.Enabled, lowDatastoreSpaceDetection)
+
+	return a
+}
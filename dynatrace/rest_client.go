@@ -0,0 +1,135 @@
+package dynatrace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// restClient is a minimal JSON client for the Dynatrace API endpoints which are not covered by the generated API clients.
+type restClient struct {
+	basePath   string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// restError is returned by the restClient for every response with a non 2xx status code.
+type restError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e restError) Error() string {
+	return fmt.Sprintf("dynatrace API responded with status code %d: %s", e.StatusCode, e.Body)
+}
+
+// entityShortRepresentation is the short representation of a Dynatrace entity returned on creation.
+type entityShortRepresentation struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func newRestClient(basePath string, apiToken string) *restClient {
+	return &restClient{
+		basePath:   basePath,
+		apiToken:   apiToken,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *restClient) get(ctx context.Context, path string, out interface{}) error {
+	_, err := c.do(ctx, http.MethodGet, path, nil, out)
+	return err
+}
+
+func (c *restClient) post(ctx context.Context, path string, in interface{}, out interface{}) error {
+	_, err := c.do(ctx, http.MethodPost, path, in, out)
+	return err
+}
+
+// postLocation creates an entity whose ID is only returned in the Location header of the response, e.g. by /slo.
+func (c *restClient) postLocation(ctx context.Context, path string, in interface{}) (string, error) {
+	header, err := c.do(ctx, http.MethodPost, path, in, nil)
+	if err != nil {
+		return "", err
+	}
+
+	location := header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("dynatrace API responded without a Location header")
+	}
+
+	return location[strings.LastIndex(location, "/")+1:], nil
+}
+
+func (c *restClient) put(ctx context.Context, path string, in interface{}, out interface{}) error {
+	_, err := c.do(ctx, http.MethodPut, path, in, out)
+	return err
+}
+
+func (c *restClient) delete(ctx context.Context, path string) error {
+	_, err := c.do(ctx, http.MethodDelete, path, nil, nil)
+	return err
+}
+
+func (c *restClient) do(ctx context.Context, method string, path string, in interface{}, out interface{}) (http.Header, error) {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.basePath+path, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Api-Token "+c.apiToken)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, restError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return resp.Header, nil
+	}
+
+	return resp.Header, json.Unmarshal(respBody, out)
+}
+
+// isNotFound reports whether err is a restError caused by a 404 response.
+func isNotFound(err error) bool {
+	if restErr, ok := err.(restError); ok {
+		return restErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// isForbidden reports whether err is a restError caused by a 403 response.
+func isForbidden(err error) bool {
+	if restErr, ok := err.(restError); ok {
+		return restErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
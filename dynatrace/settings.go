@@ -0,0 +1,434 @@
+package dynatrace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// settingsObject is a settings object as used by the Environment v2 API /settings/objects.
+type settingsObject struct {
+	ObjectID      string                 `json:"objectId,omitempty"`
+	SchemaID      string                 `json:"schemaId"`
+	SchemaVersion string                 `json:"schemaVersion,omitempty"`
+	Scope         string                 `json:"scope"`
+	Value         map[string]interface{} `json:"value"`
+}
+
+// settingsObjectCreate is an item of the request body of POST /settings/objects.
+type settingsObjectCreate struct {
+	SchemaID      string                 `json:"schemaId"`
+	SchemaVersion string                 `json:"schemaVersion,omitempty"`
+	Scope         string                 `json:"scope"`
+	Value         map[string]interface{} `json:"value"`
+	InsertAfter   *string                `json:"insertAfter,omitempty"`
+}
+
+// settingsObjectUpdate is the request body of PUT /settings/objects/{objectId}.
+type settingsObjectUpdate struct {
+	SchemaVersion string                 `json:"schemaVersion,omitempty"`
+	Value         map[string]interface{} `json:"value"`
+	InsertAfter   *string                `json:"insertAfter,omitempty"`
+}
+
+// settingsObjectResponse is an item of the response of POST /settings/objects, one per created object.
+type settingsObjectResponse struct {
+	Code     int            `json:"code"`
+	ObjectID string         `json:"objectId"`
+	Error    *settingsError `json:"error"`
+}
+
+type settingsError struct {
+	Code                 int                           `json:"code"`
+	Message              string                        `json:"message"`
+	ConstraintViolations []settingsConstraintViolation `json:"constraintViolations"`
+}
+
+type settingsConstraintViolation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (e settingsError) Error() string {
+	messages := []string{e.Message}
+	for _, violation := range e.ConstraintViolations {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Path, violation.Message))
+	}
+	return strings.Join(messages, "\n")
+}
+
+// settingsObjectList is a page of GET /settings/objects.
+type settingsObjectList struct {
+	Items       []settingsObject `json:"items"`
+	NextPageKey string           `json:"nextPageKey"`
+}
+
+// createSettingsObject creates a single settings object and returns its object ID.
+func createSettingsObject(ctx context.Context, client *restClient, object settingsObjectCreate) (string, error) {
+	var created []settingsObjectResponse
+	if err := client.post(ctx, "/settings/objects", []settingsObjectCreate{object}, &created); err != nil {
+		return "", err
+	}
+
+	if len(created) != 1 {
+		return "", fmt.Errorf("dynatrace API responded with %d instead of one settings object", len(created))
+	}
+	if created[0].Error != nil {
+		return "", created[0].Error
+	}
+
+	return created[0].ObjectID, nil
+}
+
+// listSettingsObjects returns all settings objects of a schema and scope with the given fields, in the order of the schema.
+func listSettingsObjects(ctx context.Context, client *restClient, schemaID string, scope string, fields string) ([]settingsObject, error) {
+	query := url.Values{
+		"schemaIds": {schemaID},
+		"scopes":    {scope},
+		"fields":    {fields},
+		"pageSize":  {"500"},
+	}
+
+	objects := []settingsObject{}
+	for {
+		var page settingsObjectList
+		if err := client.get(ctx, "/settings/objects?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, page.Items...)
+
+		if page.NextPageKey == "" {
+			return objects, nil
+		}
+
+		// The next page key contains all other parameters of the query
+		query = url.Values{"nextPageKey": {page.NextPageKey}}
+	}
+}
+
+// listSettingsObjectIDs returns the IDs of all settings objects of a schema and scope, in the order of the schema.
+func listSettingsObjectIDs(ctx context.Context, client *restClient, schemaID string, scope string) ([]string, error) {
+	objects, err := listSettingsObjects(ctx, client, schemaID, scope, "objectId")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(objects))
+	for i, object := range objects {
+		ids[i] = object.ObjectID
+	}
+
+	return ids, nil
+}
+
+// settingsSchema is the part of a settings schema of GET /settings/schemas/{schemaId} which is needed to validate values.
+type settingsSchema struct {
+	SchemaID    string                            `json:"schemaId"`
+	Version     string                            `json:"version"`
+	Ordered     bool                              `json:"ordered"`
+	MultiObject bool                              `json:"multiObject"`
+	Properties  map[string]settingsSchemaProperty `json:"properties"`
+	Types       map[string]settingsSchemaType     `json:"types"`
+	Enums       map[string]settingsSchemaEnum     `json:"enums"`
+}
+
+type settingsSchemaProperty struct {
+	DisplayName  string                `json:"displayName"`
+	Description  string                `json:"description"`
+	Type         settingsSchemaTypeRef `json:"type"`
+	Items        *settingsSchemaItems  `json:"items"`
+	Nullable     bool                  `json:"nullable"`
+	Default      interface{}           `json:"default"`
+	Precondition interface{}           `json:"precondition"`
+}
+
+type settingsSchemaItems struct {
+	Type settingsSchemaTypeRef `json:"type"`
+}
+
+type settingsSchemaType struct {
+	Properties map[string]settingsSchemaProperty `json:"properties"`
+}
+
+type settingsSchemaEnum struct {
+	Items []settingsSchemaEnumItem `json:"items"`
+}
+
+type settingsSchemaEnumItem struct {
+	Value interface{} `json:"value"`
+}
+
+// settingsSchemaTypeRef is the type of a property, either a primitive type like text or a reference like #/types/Rule.
+type settingsSchemaTypeRef struct {
+	Primitive string
+	Ref       string
+}
+
+func (t *settingsSchemaTypeRef) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.Primitive); err == nil {
+		return nil
+	}
+
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if err := json.Unmarshal(data, &ref); err != nil {
+		return err
+	}
+	t.Ref = ref.Ref
+
+	return nil
+}
+
+// settingsSchemaCache caches the settings schemas by schema ID and version, which are fetched once per provider.
+type settingsSchemaCache struct {
+	mutex   sync.Mutex
+	schemas map[string]*settingsSchema
+}
+
+// getSettingsSchema returns the settings schema of the given version, or the latest version if the version is empty.
+func getSettingsSchema(ctx context.Context, m interface{}, schemaID string, schemaVersion string) (*settingsSchema, error) {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+	cache := providerConf.settingsSchemas
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	key := schemaID + "@" + schemaVersion
+	if cached, ok := cache.schemas[key]; ok {
+		return cached, nil
+	}
+
+	path := "/settings/schemas/" + url.PathEscape(schemaID)
+	if schemaVersion != "" {
+		path += "?" + url.Values{"schemaVersion": {schemaVersion}}.Encode()
+	}
+
+	fetched := &settingsSchema{}
+	if err := dynatraceEnvRestClientV2.get(ctx, path, fetched); err != nil {
+		return nil, err
+	}
+
+	if cache.schemas == nil {
+		cache.schemas = make(map[string]*settingsSchema)
+	}
+	cache.schemas[key] = fetched
+
+	return fetched, nil
+}
+
+// validate returns an error for every property of the value which doesn't match the schema. Properties with a precondition
+// may be hidden depending on other properties, so they are never reported as missing.
+func (s *settingsSchema) validate(value map[string]interface{}) []error {
+	return s.validateProperties("", s.Properties, value)
+}
+
+func (s *settingsSchema) validateProperties(path string, properties map[string]settingsSchemaProperty, value map[string]interface{}) []error {
+	errs := []error{}
+
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := properties[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown property", path+key))
+			continue
+		}
+
+		errs = append(errs, s.validateValue(path+key, property.Type, property.Items, property.Nullable, value[key])...)
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := properties[name]
+		if _, ok := value[name]; !ok && !property.Nullable && property.Default == nil && property.Precondition == nil {
+			errs = append(errs, fmt.Errorf("%s: missing required property", path+name))
+		}
+	}
+
+	return errs
+}
+
+func (s *settingsSchema) validateValue(path string, typeRef settingsSchemaTypeRef, items *settingsSchemaItems, nullable bool, value interface{}) []error {
+	if value == nil {
+		if nullable {
+			return nil
+		}
+		return []error{fmt.Errorf("%s: must not be null", path)}
+	}
+
+	if strings.HasPrefix(typeRef.Ref, "#/types/") {
+		settingsType, ok := s.Types[strings.TrimPrefix(typeRef.Ref, "#/types/")]
+		object, isObject := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if !isObject {
+			return []error{fmt.Errorf("%s: must be an object", path)}
+		}
+		return s.validateProperties(path+".", settingsType.Properties, object)
+	}
+
+	if strings.HasPrefix(typeRef.Ref, "#/enums/") {
+		settingsEnum, ok := s.Enums[strings.TrimPrefix(typeRef.Ref, "#/enums/")]
+		if !ok {
+			return nil
+		}
+		allowed := []string{}
+		for _, item := range settingsEnum.Items {
+			if item.Value == value {
+				return nil
+			}
+			allowed = append(allowed, fmt.Sprint(item.Value))
+		}
+		return []error{fmt.Errorf("%s: must be one of %s", path, strings.Join(allowed, ", "))}
+	}
+
+	switch typeRef.Primitive {
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []error{fmt.Errorf("%s: must be a boolean", path)}
+		}
+	case "integer":
+		if number, ok := value.(float64); !ok || number != float64(int64(number)) {
+			return []error{fmt.Errorf("%s: must be an integer", path)}
+		}
+	case "float":
+		if _, ok := value.(float64); !ok {
+			return []error{fmt.Errorf("%s: must be a number", path)}
+		}
+	case "text", "secret":
+		if _, ok := value.(string); !ok {
+			return []error{fmt.Errorf("%s: must be a string", path)}
+		}
+	case "set", "list":
+		elements, ok := value.([]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: must be an array", path)}
+		}
+		if items == nil {
+			return nil
+		}
+		errs := []error{}
+		for i, element := range elements {
+			errs = append(errs, s.validateValue(fmt.Sprintf("%s[%d]", path, i), items.Type, nil, false, element)...)
+		}
+		return errs
+	}
+
+	// Other primitive types like local_time or time_zone are validated by the API
+	return nil
+}
+
+// keepSecrets replaces the secrets of the value returned by the API, which are masked, with their configured values.
+func (s *settingsSchema) keepSecrets(remote map[string]interface{}, configured map[string]interface{}) {
+	s.keepPropertySecrets(s.Properties, remote, configured)
+}
+
+func (s *settingsSchema) keepPropertySecrets(properties map[string]settingsSchemaProperty, remote map[string]interface{}, configured map[string]interface{}) {
+	for key, property := range properties {
+		configuredValue, ok := configured[key]
+		if !ok {
+			continue
+		}
+
+		typeRef := property.Type
+		if property.Items != nil {
+			typeRef = property.Items.Type
+		}
+
+		if typeRef.Primitive == "secret" && property.Items == nil {
+			remote[key] = configuredValue
+			continue
+		}
+
+		if !strings.HasPrefix(typeRef.Ref, "#/types/") {
+			continue
+		}
+		settingsType, ok := s.Types[strings.TrimPrefix(typeRef.Ref, "#/types/")]
+		if !ok {
+			continue
+		}
+
+		if property.Items == nil {
+			remoteObject, remoteOK := remote[key].(map[string]interface{})
+			configuredObject, configuredOK := configuredValue.(map[string]interface{})
+			if remoteOK && configuredOK {
+				s.keepPropertySecrets(settingsType.Properties, remoteObject, configuredObject)
+			}
+			continue
+		}
+
+		// Elements of lists are matched by their position, like the projection of the value
+		remoteElements, _ := remote[key].([]interface{})
+		configuredElements, _ := configuredValue.([]interface{})
+		for i := 0; i < len(remoteElements) && i < len(configuredElements); i++ {
+			remoteObject, remoteOK := remoteElements[i].(map[string]interface{})
+			configuredObject, configuredOK := configuredElements[i].(map[string]interface{})
+			if remoteOK && configuredOK {
+				s.keepPropertySecrets(settingsType.Properties, remoteObject, configuredObject)
+			}
+		}
+	}
+}
+
+// projectSettingsValue reduces the value returned by the API to the properties of the configured value, so properties
+// which are left to their defaults don't show up as changes. Without a configured value, e.g. on import, all properties are kept.
+func projectSettingsValue(remote interface{}, configured interface{}) interface{} {
+	switch configuredValue := configured.(type) {
+	case map[string]interface{}:
+		remoteValue, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		projected := make(map[string]interface{}, len(configuredValue))
+		for key, value := range configuredValue {
+			if remoteElement, ok := remoteValue[key]; ok {
+				projected[key] = projectSettingsValue(remoteElement, value)
+			}
+		}
+		return projected
+	case []interface{}:
+		remoteValue, ok := remote.([]interface{})
+		if !ok || len(remoteValue) != len(configuredValue) {
+			return remote
+		}
+		projected := make([]interface{}, len(remoteValue))
+		for i := range remoteValue {
+			projected[i] = projectSettingsValue(remoteValue[i], configuredValue[i])
+		}
+		return projected
+	}
+
+	return remote
+}
+
+// normalizeSettingsValue returns the JSON encoding of the value with sorted keys, which is stable across plans.
+func normalizeSettingsValue(value string) (string, error) {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
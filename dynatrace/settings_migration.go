@@ -0,0 +1,334 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// legacyUUIDPattern matches the UUIDs which identify most configurations of the Config v1 API, e.g. alerting profiles.
+var legacyUUIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// legacySettingsResource maps a resource of the Config v1 API onto the objects of a settings schema, which allows to
+// manage it through Settings 2.0 without changing its attributes. It is used instead of the Config v1 API if the
+// provider is configured with settings_v2.
+type legacySettingsResource struct {
+	SchemaID string
+	// NameAttribute is the attribute of the unique name, which identifies the settings object of a Config v1 ID.
+	NameAttribute string
+	// LegacyPath and LegacyNameProperty locate the name of a Config v1 configuration, which is needed if the state
+	// doesn't have the name yet, e.g. after an import with the Config v1 ID.
+	LegacyPath         string
+	LegacyNameProperty string
+	// LegacyIDAttribute is the optional computed attribute of the Config v1 ID, which is still needed by resources
+	// which reference the configuration through the Config v1 API.
+	LegacyIDAttribute string
+	Expand            func(d *schema.ResourceData) (map[string]interface{}, error)
+	Flatten           func(d *schema.ResourceData, value map[string]interface{}) error
+}
+
+func (r *legacySettingsResource) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	value, err := r.Expand(d)
+	if err == nil {
+		var objectID string
+		objectID, err = createSettingsObject(ctx, dynatraceEnvRestClientV2, settingsObjectCreate{
+			SchemaID: r.SchemaID,
+			Scope:    "environment",
+			Value:    value,
+		})
+		d.SetId(objectID)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to create dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return r.read(ctx, d, m)
+}
+
+func (r *legacySettingsResource) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read"); diags.HasError() {
+		return diags
+	}
+
+	// Resources which were created or imported with the Config v1 API still have its ID
+	legacyID := ""
+	if isLegacyConfigID(d.Id()) {
+		legacyID = d.Id()
+		objectID, err := r.settingsObjectID(ctx, m, d.Id(), d.Get(r.NameAttribute).(string))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to migrate dynatrace configuration %s to a %s settings object", d.Id(), r.SchemaID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		d.SetId(objectID)
+	}
+
+	var object settingsObject
+	err := dynatraceEnvRestClientV2.get(ctx, "/settings/objects/"+url.PathEscape(d.Id()), &object)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err == nil && object.SchemaID != r.SchemaID {
+		err = fmt.Errorf("the settings object %s belongs to the schema %s", d.Id(), object.SchemaID)
+	}
+	if err == nil {
+		err = r.Flatten(d, object.Value)
+	}
+	if err == nil && r.LegacyIDAttribute != "" {
+		// The Config v1 ID doesn't change, so it is only looked up if it isn't known yet
+		if legacyID == "" {
+			legacyID = d.Get(r.LegacyIDAttribute).(string)
+		}
+		if legacyID == "" {
+			legacyID, err = r.legacyConfigID(ctx, m, d.Get(r.NameAttribute).(string))
+		}
+		d.Set(r.LegacyIDAttribute, legacyID)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to read dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func (r *legacySettingsResource) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	value, err := r.Expand(d)
+	if err == nil {
+		err = dynatraceEnvRestClientV2.put(ctx, "/settings/objects/"+url.PathEscape(d.Id()), settingsObjectUpdate{Value: value}, nil)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to update dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return r.read(ctx, d, m)
+}
+
+func (r *legacySettingsResource) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.delete(ctx, "/settings/objects/"+url.PathEscape(d.Id()))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to delete dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// upgradeState upgrades the state of schema version 0, i.e. of the Config v1 API, by replacing the Config v1 ID with the
+// ID of the settings object. Without settings_v2 the state is left unchanged and the ID is replaced by the next read
+// once settings_v2 is enabled.
+func (r *legacySettingsResource) upgradeState(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	providerConf, ok := m.(*ProviderConfiguration)
+	if !ok || !providerConf.SettingsV2 {
+		return rawState, nil
+	}
+
+	id, _ := rawState["id"].(string)
+	name, _ := rawState[r.NameAttribute].(string)
+	if !isLegacyConfigID(id) {
+		return rawState, nil
+	}
+
+	objectID, err := r.settingsObjectID(ctx, m, id, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate dynatrace configuration %s to a %s settings object: %s", id, r.SchemaID, err.Error())
+	}
+	rawState["id"] = objectID
+	if r.LegacyIDAttribute != "" {
+		rawState[r.LegacyIDAttribute] = id
+	}
+
+	return rawState, nil
+}
+
+// settingsObjectID returns the ID of the settings object which corresponds to a configuration of the Config v1 API.
+// The IDs of both APIs are unrelated, so the settings object is identified by the unique name of the configuration.
+func (r *legacySettingsResource) settingsObjectID(ctx context.Context, m interface{}, legacyID string, name string) (string, error) {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	if name == "" {
+		var legacy map[string]interface{}
+		if err := providerConf.DynatraceConfigRestClientV1.get(ctx, r.LegacyPath+"/"+url.PathEscape(legacyID), &legacy); err != nil {
+			return "", fmt.Errorf("unable to read the name of the configuration %s: %s", legacyID, err.Error())
+		}
+		name = settingsString(legacy, r.LegacyNameProperty)
+	}
+
+	objects, err := listSettingsObjects(ctx, dynatraceEnvRestClientV2, r.SchemaID, "environment", "objectId,value")
+	if err != nil {
+		return "", err
+	}
+
+	matches := []string{}
+	for _, object := range objects {
+		if settingsString(object.Value, "name") == name {
+			matches = append(matches, object.ObjectID)
+		}
+	}
+
+	if len(matches) != 1 {
+		return "", fmt.Errorf("expected one settings object named %q, found %d", name, len(matches))
+	}
+
+	return matches[0], nil
+}
+
+// legacyConfigID returns the Config v1 ID of the configuration with the given name, the inverse of settingsObjectID.
+func (r *legacySettingsResource) legacyConfigID(ctx context.Context, m interface{}, name string) (string, error) {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceConfigRestClientV1 := providerConf.DynatraceConfigRestClientV1
+
+	var stubs struct {
+		Values []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"values"`
+	}
+	if err := dynatraceConfigRestClientV1.get(ctx, r.LegacyPath, &stubs); err != nil {
+		return "", fmt.Errorf("unable to read the Config v1 ID of %q: %s", name, err.Error())
+	}
+
+	matches := []string{}
+	for _, stub := range stubs.Values {
+		if stub.Name == name {
+			matches = append(matches, stub.ID)
+		}
+	}
+
+	if len(matches) != 1 {
+		return "", fmt.Errorf("expected one Config v1 configuration named %q, found %d", name, len(matches))
+	}
+
+	return matches[0], nil
+}
+
+// isLegacyConfigID reports whether an ID is an ID of the Config v1 API, i.e. a UUID or a number like the IDs of
+// management zones, rather than the ID of a settings object.
+func isLegacyConfigID(id string) bool {
+	if _, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return true
+	}
+
+	return legacyUUIDPattern.MatchString(id)
+}
+
+// settingsString, settingsBool, settingsInt, settingsMap and settingsSlice read a property of a settings value,
+// returning the zero value if the property is missing or null.
+func settingsString(value map[string]interface{}, key string) string {
+	s, _ := value[key].(string)
+	return s
+}
+
+func settingsBool(value map[string]interface{}, key string) bool {
+	b, _ := value[key].(bool)
+	return b
+}
+
+func settingsInt(value map[string]interface{}, key string) int {
+	f, _ := value[key].(float64)
+	return int(f)
+}
+
+func settingsMap(value map[string]interface{}, key string) map[string]interface{} {
+	m, _ := value[key].(map[string]interface{})
+	return m
+}
+
+func settingsSlice(value map[string]interface{}, key string) []interface{} {
+	s, _ := value[key].([]interface{})
+	return s
+}
+
+// formatSettingsTag formats a tag the way Settings 2.0 expects it, e.g. [AWS]key:value, or key:value for custom tags.
+func formatSettingsTag(context string, key string, value string) string {
+	tag := key
+	if value != "" {
+		tag += ":" + value
+	}
+	if context != "" && context != "CONTEXTLESS" {
+		tag = "[" + context + "]" + tag
+	}
+
+	return tag
+}
+
+// parseSettingsTag is the inverse of formatSettingsTag.
+func parseSettingsTag(tag string) (string, string, string) {
+	context := "CONTEXTLESS"
+	if strings.HasPrefix(tag, "[") {
+		if end := strings.Index(tag, "]"); end > 0 {
+			context = tag[1:end]
+			tag = tag[end+1:]
+		}
+	}
+
+	if i := strings.Index(tag, ":"); i >= 0 {
+		return context, tag[:i], tag[i+1:]
+	}
+
+	return context, tag, ""
+}
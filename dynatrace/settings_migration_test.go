@@ -0,0 +1,281 @@
+package dynatrace
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIsLegacyConfigID(t *testing.T) {
+	tests := map[string]bool{
+		"4638826838889583423":                  true,
+		"-4638826838889583423":                 true,
+		"0":                                    true,
+		"b4b6a9a8-4d3e-4e2f-9c5e-3b1c2d4e5f6a": true,
+		"B4B6A9A8-4D3E-4E2F-9C5E-3B1C2D4E5F6A": true,
+		"vu9U3hXa3q0AAAABABhidWlsdGluOm1hbmFnZW1lbnQtem9uZXMABnRlbmFudAAGdGVuYW50ACQ2ZGQ4YjM0NS1iMzkxLTM0ZGMtYjQ3MS1mNGIyZmE4ZjRkMDG-71TeFdrerQ": false,
+		"":                        false,
+		"b4b6a9a8-4d3e-4e2f-9c5e": false,
+		"12a":                     false,
+		"99999999999999999999":    false,
+	}
+
+	for id, expected := range tests {
+		if actual := isLegacyConfigID(id); actual != expected {
+			t.Errorf("isLegacyConfigID(%q): expected %t, got %t", id, expected, actual)
+		}
+	}
+}
+
+func TestSettingsTag(t *testing.T) {
+	tests := []struct {
+		context string
+		key     string
+		value   string
+		tag     string
+	}{
+		{"CONTEXTLESS", "app", "sockshop", "app:sockshop"},
+		{"CONTEXTLESS", "production", "", "production"},
+		{"AWS", "Name", "carts", "[AWS]Name:carts"},
+		{"KUBERNETES", "app.kubernetes.io/name", "", "[KUBERNETES]app.kubernetes.io/name"},
+		{"CONTEXTLESS", "url", "http://carts:8080", "url:http://carts:8080"},
+	}
+
+	for _, test := range tests {
+		tag := formatSettingsTag(test.context, test.key, test.value)
+		if tag != test.tag {
+			t.Errorf("formatSettingsTag(%q, %q, %q): expected %q, got %q", test.context, test.key, test.value, test.tag, tag)
+		}
+
+		context, key, value := parseSettingsTag(tag)
+		if context != test.context || key != test.key || value != test.value {
+			t.Errorf("parseSettingsTag(%q): expected %q, %q, %q, got %q, %q, %q", tag, test.context, test.key, test.value, context, key, value)
+		}
+	}
+
+	if context, key, value := parseSettingsTag("app:sockshop"); context != "CONTEXTLESS" || key != "app" || value != "sockshop" {
+		t.Errorf("an empty context must be parsed as CONTEXTLESS, got %q, %q, %q", context, key, value)
+	}
+	if tag := formatSettingsTag("", "app", ""); tag != "app" {
+		t.Errorf("an empty context must be formatted like CONTEXTLESS, got %q", tag)
+	}
+}
+
+// testSettingsRoundTrip expands the configuration into a settings value, encodes it like the API and flattens it into
+// the state of the same configuration, which must result in the configured attributes.
+func testSettingsRoundTrip(t *testing.T, r *legacySettingsResource, resourceSchema map[string]*schema.Schema, raw map[string]interface{}, attributes ...string) {
+	d := schema.TestResourceDataRaw(t, resourceSchema, raw)
+
+	value, err := r.Expand(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remote map[string]interface{}
+	if err := json.Unmarshal(encoded, &remote); err != nil {
+		t.Fatal(err)
+	}
+
+	state := schema.TestResourceDataRaw(t, resourceSchema, raw)
+	if err := r.Flatten(state, remote); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, attribute := range attributes {
+		expected, actual := testPlainResourceData(d.Get(attribute)), testPlainResourceData(state.Get(attribute))
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %v, got %v", attribute, expected, actual)
+		}
+	}
+}
+
+// testPlainResourceData replaces the sets of a value read from the resource data with their elements, so values can be compared.
+func testPlainResourceData(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return testPlainResourceData(v.List())
+	case []interface{}:
+		plain := make([]interface{}, len(v))
+		for i, element := range v {
+			plain[i] = testPlainResourceData(element)
+		}
+		return plain
+	case map[string]interface{}:
+		plain := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			plain[key] = testPlainResourceData(element)
+		}
+		return plain
+	}
+
+	return value
+}
+
+func TestManagementZoneSettingsV2RoundTrip(t *testing.T) {
+	raw := map[string]interface{}{
+		"name": "sockshop",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"type":              "SERVICE",
+				"enabled":           true,
+				"propagation_types": []interface{}{"SERVICE_TO_HOST_LIKE", "SERVICE_TO_PROCESS_GROUP_LIKE"},
+				"condition": []interface{}{
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "SERVICE_TAGS"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "TAG",
+							"operator": "EQUALS",
+							"negate":   false,
+							"value":    map[string]interface{}{"context": "CONTEXTLESS", "key": "app", "value": "sockshop"},
+						}},
+					},
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "SERVICE_TAGS"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "TAG",
+							"operator": "EQUALS",
+							"negate":   true,
+							"value":    map[string]interface{}{"context": "AWS", "key": "environment"},
+						}},
+					},
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "SERVICE_NAME"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "STRING",
+							"operator": "BEGINS_WITH",
+							"negate":   false,
+							"value":    map[string]interface{}{"value": "carts", "case_sensitive": "true"},
+						}},
+					},
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "SERVICE_TYPE"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "SERVICE_TYPE",
+							"operator": "EQUALS",
+							"negate":   false,
+							"value":    map[string]interface{}{"value": "WEB_SERVICE"},
+						}},
+					},
+				},
+			},
+			map[string]interface{}{
+				"type":    "HOST",
+				"enabled": false,
+				"condition": []interface{}{
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "HOST_CPU_CORES"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "INTEGER",
+							"operator": "GREATER_THAN",
+							"negate":   false,
+							"value":    map[string]interface{}{"value": "4"},
+						}},
+					},
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "HOST_GROUP_ID"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "ENTITY_ID",
+							"operator": "EQUALS",
+							"negate":   false,
+							"value":    map[string]interface{}{"value": "HOST_GROUP-1234567890ABCDEF"},
+						}},
+					},
+					map[string]interface{}{
+						"key": []interface{}{map[string]interface{}{"attribute": "HOST_NAME"}},
+						"comparison_info": []interface{}{map[string]interface{}{
+							"type":     "STRING",
+							"operator": "EXISTS",
+							"negate":   false,
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	testSettingsRoundTrip(t, managementZoneSettingsV2, resourceDynatraceManagementZones().Schema, raw, "name", "rule")
+}
+
+func TestManagementZoneSettingsV2PropagationFlags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDynatraceManagementZones().Schema, map[string]interface{}{
+		"name": "custom devices",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"type":              "CUSTOM_DEVICE_GROUP",
+				"enabled":           true,
+				"propagation_types": []interface{}{"CUSTOM_DEVICE_GROUP_TO_CUSTOM_DEVICE"},
+				"condition":         []interface{}{},
+			},
+		},
+	})
+
+	value, err := expandManagementZoneSettingsV2Value(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributeRule := value["rules"].([]interface{})[0].(map[string]interface{})["attributeRule"].(map[string]interface{})
+	expected := map[string]interface{}{
+		"entityType": "CUSTOM_DEVICE_GROUP",
+		"conditions": []interface{}{},
+		"customDeviceGroupToCustomDevicePropagation": true,
+	}
+	if !reflect.DeepEqual(attributeRule, expected) {
+		t.Errorf("expected %v, got %v", expected, attributeRule)
+	}
+}
+
+func TestAlertingProfileSettingsV2RoundTrip(t *testing.T) {
+	raw := map[string]interface{}{
+		"display_name": "sockshop",
+		"mz_id":        "-4638826838889583423",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"severity_level":   "AVAILABILITY",
+				"delay_in_minutes": 0,
+				"tag_filters": []interface{}{map[string]interface{}{
+					"include_mode": "INCLUDE_ALL",
+					"tag_filter": []interface{}{
+						map[string]interface{}{"context": "CONTEXTLESS", "key": "app", "value": "sockshop"},
+						map[string]interface{}{"context": "AWS", "key": "production", "value": ""},
+					},
+				}},
+			},
+			map[string]interface{}{
+				"severity_level":   "ERROR",
+				"delay_in_minutes": 10,
+				"tag_filters": []interface{}{map[string]interface{}{
+					"include_mode": "NONE",
+					"tag_filter":   []interface{}{},
+				}},
+			},
+		},
+		"event_type_filter": []interface{}{
+			map[string]interface{}{
+				"predefined_event_filter": []interface{}{map[string]interface{}{
+					"event_type": "OSI_HIGH_CPU",
+					"negate":     false,
+				}},
+			},
+			map[string]interface{}{
+				"custom_event_filter": []interface{}{map[string]interface{}{
+					"custom_title_filter": []interface{}{map[string]interface{}{
+						"enabled":          true,
+						"value":            "carts",
+						"operator":         "CONTAINS",
+						"negate":           false,
+						"case_insensitive": true,
+					}},
+				}},
+			},
+		},
+	}
+
+	testSettingsRoundTrip(t, alertingProfileSettingsV2, resourceDynatraceAlertingProfile().Schema, raw, "display_name", "mz_id", "rule", "event_type_filter")
+}
@@ -0,0 +1,341 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//go:generate go run ../tools/settingsgen -schemas ../settings/schemas -out .
+
+// settingsResource describes a typed resource for the objects of a settings schema. The descriptors, schemas and
+// expand/flatten functions are generated by tools/settingsgen from the settings schemas in settings/schemas.
+type settingsResource struct {
+	SchemaID      string
+	SchemaVersion string
+	Schema        func() map[string]*schema.Schema
+	Expand        func(settingsData) map[string]interface{}
+	Flatten       func(map[string]interface{}) map[string]interface{}
+}
+
+func (r *settingsResource) resource() *schema.Resource {
+	resourceSchema := r.Schema()
+	resourceSchema["scope"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "environment",
+		ForceNew:    true,
+		Description: "The scope of the settings object, either environment or the ID of an entity, e.g. HOST-1234567890.",
+	}
+
+	return &schema.Resource{
+		CreateContext: r.create,
+		ReadContext:   r.read,
+		UpdateContext: r.update,
+		DeleteContext: r.delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func (r *settingsResource) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	objectID, err := createSettingsObject(ctx, dynatraceEnvRestClientV2, settingsObjectCreate{
+		SchemaID:      r.SchemaID,
+		SchemaVersion: r.SchemaVersion,
+		Scope:         d.Get("scope").(string),
+		Value:         r.Expand(settingsData{d: d}),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to create dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(objectID)
+
+	return r.read(ctx, d, m)
+}
+
+func (r *settingsResource) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read"); diags.HasError() {
+		return diags
+	}
+
+	var object settingsObject
+	err := dynatraceEnvRestClientV2.get(ctx, "/settings/objects/"+url.PathEscape(d.Id()), &object)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err == nil && object.SchemaID != r.SchemaID {
+		err = fmt.Errorf("the settings object %s belongs to the schema %s", d.Id(), object.SchemaID)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to read dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// Secrets are masked by the API and left out by the flatten functions. Top-level secrets aren't set and keep their
+	// value, secrets of nested blocks are taken from the previous state.
+	for key, value := range r.Flatten(object.Value) {
+		if err := d.Set(key, mergeSettingsSecrets(value, d.Get(key))); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to read dynatrace %s settings object", r.SchemaID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	d.Set("scope", object.Scope)
+
+	return diags
+}
+
+func (r *settingsResource) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.read", "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	if d.HasChanges(r.keys()...) {
+		update := settingsObjectUpdate{
+			SchemaVersion: r.SchemaVersion,
+			Value:         r.Expand(settingsData{d: d}),
+		}
+
+		err := dynatraceEnvRestClientV2.put(ctx, "/settings/objects/"+url.PathEscape(d.Id()), update, nil)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to update dynatrace %s settings object", r.SchemaID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return r.read(ctx, d, m)
+}
+
+func (r *settingsResource) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+
+	var diags diag.Diagnostics
+
+	if diags := checkTokenScopes(ctx, m, "settings.write"); diags.HasError() {
+		return diags
+	}
+
+	err := dynatraceEnvRestClientV2.delete(ctx, "/settings/objects/"+url.PathEscape(d.Id()))
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to delete dynatrace %s settings object", r.SchemaID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// keys returns the attributes of the settings value, i.e. all attributes except the scope.
+func (r *settingsResource) keys() []string {
+	keys := []string{}
+	for key := range r.Schema() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// settingsData gives the generated expand functions access to the attributes of the resource or of a nested block.
+type settingsData struct {
+	d    *schema.ResourceData
+	path string
+}
+
+func (s settingsData) get(attribute string) interface{} {
+	return s.d.Get(s.path + attribute)
+}
+
+// configured reports whether an attribute has a value, configured or kept from the state. Unlike a check of the zero
+// value, it is true for false, 0 and "", and false for a computed attribute, which is unknown until the API applies its default.
+func (s settingsData) configured(attribute string) bool {
+	_, ok := s.d.GetOkExists(s.path + attribute)
+	return ok
+}
+
+// blocks returns the nested blocks of a list attribute.
+func (s settingsData) blocks(attribute string) []settingsData {
+	list, _ := s.get(attribute).([]interface{})
+
+	blocks := []settingsData{}
+	for i, block := range list {
+		if block != nil {
+			blocks = append(blocks, settingsData{d: s.d, path: fmt.Sprintf("%s%s.%d.", s.path, attribute, i)})
+		}
+	}
+
+	return blocks
+}
+
+// settingsList returns the elements of a list or set read from the resource data.
+func settingsList(values interface{}) []interface{} {
+	switch v := values.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return nil
+}
+
+// expandSettingsList expands a list of nested blocks into a list of settings values.
+func expandSettingsList(s settingsData, attribute string, expand func(settingsData) map[string]interface{}) []interface{} {
+	expanded := []interface{}{}
+	for _, block := range s.blocks(attribute) {
+		expanded = append(expanded, expand(block))
+	}
+
+	return expanded
+}
+
+// expandSettingsBlock expands a single nested block into a settings value, or nil if the block isn't configured.
+func expandSettingsBlock(s settingsData, attribute string, expand func(settingsData) map[string]interface{}) interface{} {
+	blocks := s.blocks(attribute)
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	return expand(blocks[0])
+}
+
+// expandSettingsPrimitives expands a list or set of primitive values.
+func expandSettingsPrimitives(values interface{}) []interface{} {
+	expanded := []interface{}{}
+	for _, value := range settingsList(values) {
+		if value != nil {
+			expanded = append(expanded, value)
+		}
+	}
+
+	return expanded
+}
+
+// setSettingsOptional sets an optional list or nested block of a settings value, unless it is empty, so the API applies its default.
+func setSettingsOptional(value map[string]interface{}, key string, v interface{}) {
+	switch x := v.(type) {
+	case nil:
+		return
+	case []interface{}:
+		if len(x) == 0 {
+			return
+		}
+	}
+
+	value[key] = v
+}
+
+// flattenSettingsList flattens a list of settings values into a list of nested blocks.
+func flattenSettingsList(value interface{}, flatten func(map[string]interface{}) map[string]interface{}) []interface{} {
+	list, _ := value.([]interface{})
+
+	flattened := make([]interface{}, 0, len(list))
+	for _, element := range list {
+		if element, ok := element.(map[string]interface{}); ok {
+			flattened = append(flattened, flatten(element))
+		}
+	}
+
+	return flattened
+}
+
+// flattenSettingsBlock flattens a settings value into a single nested block.
+func flattenSettingsBlock(value interface{}, flatten func(map[string]interface{}) map[string]interface{}) []interface{} {
+	if element, ok := value.(map[string]interface{}); ok {
+		return []interface{}{flatten(element)}
+	}
+
+	return []interface{}{}
+}
+
+// mergeSettingsSecrets copies the attributes which are missing in flattened nested blocks, i.e. the secrets, from the
+// blocks at the same position in the previous state.
+func mergeSettingsSecrets(flattened interface{}, previous interface{}) interface{} {
+	blocks, ok := flattened.([]interface{})
+	if !ok {
+		return flattened
+	}
+	previousBlocks := settingsList(previous)
+
+	for i, block := range blocks {
+		m, ok := block.(map[string]interface{})
+		if !ok || i >= len(previousBlocks) {
+			continue
+		}
+		p, ok := previousBlocks[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for key, previousValue := range p {
+			if value, ok := m[key]; ok {
+				m[key] = mergeSettingsSecrets(value, previousValue)
+			} else {
+				m[key] = previousValue
+			}
+		}
+	}
+
+	return blocks
+}
+
+// flattenSettingsPrimitives flattens a list of primitive values.
+func flattenSettingsPrimitives(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	if list == nil {
+		return []interface{}{}
+	}
+
+	return list
+}
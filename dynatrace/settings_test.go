@@ -0,0 +1,156 @@
+package dynatrace
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testSettingsSchema = `{
+	"schemaId": "builtin:test",
+	"version": "1.0",
+	"properties": {
+		"name": {"type": "text", "nullable": false},
+		"enabled": {"type": "boolean", "nullable": false, "default": true},
+		"delay": {"type": "integer", "nullable": true},
+		"ratio": {"type": "float", "nullable": true},
+		"severity": {"type": {"$ref": "#/enums/Severity"}, "nullable": false, "default": "ERROR"},
+		"token": {"type": "secret", "nullable": true},
+		"hidden": {"type": "text", "nullable": false, "precondition": {"type": "EQUALS", "property": "enabled", "expectedValue": true}},
+		"tags": {"type": "set", "items": {"type": "text"}, "nullable": true},
+		"rules": {"type": "list", "items": {"type": {"$ref": "#/types/Rule"}}, "nullable": true},
+		"filter": {"type": {"$ref": "#/types/Filter"}, "nullable": true}
+	},
+	"types": {
+		"Rule": {"properties": {"key": {"type": "text", "nullable": false}, "password": {"type": "secret", "nullable": true}}},
+		"Filter": {"properties": {"value": {"type": "text", "nullable": false}}}
+	},
+	"enums": {
+		"Severity": {"items": [{"value": "ERROR"}, {"value": "WARNING"}]}
+	}
+}`
+
+func testSettingsSchemaValue(t *testing.T) *settingsSchema {
+	s := &settingsSchema{}
+	if err := json.Unmarshal([]byte(testSettingsSchema), s); err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func testSettingsValue(t *testing.T, value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	return decoded
+}
+
+func TestSettingsSchemaValidate(t *testing.T) {
+	s := testSettingsSchemaValue(t)
+
+	tests := []struct {
+		name   string
+		value  string
+		errors []string
+	}{
+		{"minimal", `{"name": "a"}`, []string{}},
+		{"complete", `{"name": "a", "enabled": false, "delay": 5, "ratio": 0.5, "severity": "WARNING", "token": "t", "hidden": "h", "tags": ["a"], "rules": [{"key": "k"}], "filter": {"value": "v"}}`, []string{}},
+		{"nullable", `{"name": "a", "delay": null, "filter": null}`, []string{}},
+		{"missing required", `{}`, []string{"name: missing required property"}},
+		{"null required", `{"name": null}`, []string{"name: must not be null"}},
+		{"unknown", `{"name": "a", "other": 1}`, []string{"other: unknown property"}},
+		{"boolean", `{"name": "a", "enabled": "true"}`, []string{"enabled: must be a boolean"}},
+		{"integer", `{"name": "a", "delay": 1.5}`, []string{"delay: must be an integer"}},
+		{"float", `{"name": "a", "ratio": "1"}`, []string{"ratio: must be a number"}},
+		{"text", `{"name": 1}`, []string{"name: must be a string"}},
+		{"enum", `{"name": "a", "severity": "INFO"}`, []string{"severity: must be one of ERROR, WARNING"}},
+		{"array", `{"name": "a", "tags": "a"}`, []string{"tags: must be an array"}},
+		{"array items", `{"name": "a", "tags": ["a", 1]}`, []string{"tags[1]: must be a string"}},
+		{"object", `{"name": "a", "filter": "v"}`, []string{"filter: must be an object"}},
+		{"nested", `{"name": "a", "rules": [{"key": "k"}, {"other": 1}]}`, []string{"rules[1].other: unknown property", "rules[1].key: missing required property"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := s.validate(testSettingsValue(t, test.value).(map[string]interface{}))
+
+			messages := []string{}
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			if !reflect.DeepEqual(messages, test.errors) {
+				t.Errorf("expected %q, got %q", test.errors, messages)
+			}
+		})
+	}
+}
+
+func TestProjectSettingsValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		remote     string
+		configured string
+		expected   string
+	}{
+		{"defaults", `{"name": "a", "enabled": true}`, `{"name": "a"}`, `{"name": "a"}`},
+		{"changed", `{"name": "b", "enabled": true}`, `{"name": "a"}`, `{"name": "b"}`},
+		{"removed", `{"enabled": true}`, `{"name": "a"}`, `{}`},
+		{"nested", `{"filter": {"value": "v", "caseSensitive": false}}`, `{"filter": {"value": "v"}}`, `{"filter": {"value": "v"}}`},
+		{"list", `{"rules": [{"key": "a", "delay": 0}, {"key": "b", "delay": 1}]}`, `{"rules": [{"key": "a"}, {"key": "b", "delay": 1}]}`, `{"rules": [{"key": "a"}, {"key": "b", "delay": 1}]}`},
+		{"list length", `{"rules": [{"key": "a", "delay": 0}]}`, `{"rules": [{"key": "a"}, {"key": "b"}]}`, `{"rules": [{"key": "a", "delay": 0}]}`},
+		{"type change", `{"filter": "v"}`, `{"filter": {"value": "v"}}`, `{"filter": "v"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			projected := projectSettingsValue(testSettingsValue(t, test.remote), testSettingsValue(t, test.configured))
+			if expected := testSettingsValue(t, test.expected); !reflect.DeepEqual(projected, expected) {
+				t.Errorf("expected %v, got %v", expected, projected)
+			}
+		})
+	}
+}
+
+func TestNormalizeSettingsValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{`{}`, `{}`},
+		{`{"b": 1, "a": 2}`, `{"a":2,"b":1}`},
+		{"{\n  \"a\": [ 1, 2 ],\n  \"b\": { \"d\": true, \"c\": null }\n}", `{"a":[1,2],"b":{"c":null,"d":true}}`},
+	}
+
+	for _, test := range tests {
+		normalized, err := normalizeSettingsValue(test.value)
+		if err != nil {
+			t.Errorf("%s: %s", test.value, err)
+			continue
+		}
+		if normalized != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.value, test.expected, normalized)
+		}
+	}
+
+	if _, err := normalizeSettingsValue(`{"a":`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestFlattenSettingsValueKeepsSecrets(t *testing.T) {
+	s := testSettingsSchemaValue(t)
+
+	remote := testSettingsValue(t, `{"name": "a", "token": "******", "rules": [{"key": "k", "password": "******"}], "enabled": true}`).(map[string]interface{})
+	configured := `{"name": "a", "token": "t", "rules": [{"key": "k", "password": "p"}]}`
+
+	value, err := flattenSettingsValue(s, remote, configured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"name":"a","rules":[{"key":"k","password":"p"}],"token":"t"}`; value != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+}
@@ -0,0 +1,32 @@
+package dynatrace
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Singleton settings, e.g. the anomaly detection of services, always exist in an environment and can't be deleted.
+// Creating their resource takes over the current settings with the configured state, destroying it restores the
+// Dynatrace defaults.
+
+// createSingletonSettings applies the configured settings and sets the fixed ID of the resource.
+func createSingletonSettings(d *schema.ResourceData, id string, update func() diag.Diagnostics) diag.Diagnostics {
+	if diags := update(); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// deleteSingletonSettings restores the Dynatrace defaults and removes the resource from the state.
+func deleteSingletonSettings(d *schema.ResourceData, restoreDefaults func() diag.Diagnostics) diag.Diagnostics {
+	if diags := restoreDefaults(); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package dynatrace
+
+// expandStringList converts a list or set of strings read from the resource data into a string slice.
+func expandStringList(values []interface{}) []string {
+	ss := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if v != nil {
+			ss = append(ss, v.(string))
+		}
+	}
+
+	return ss
+}
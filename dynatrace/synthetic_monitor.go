@@ -0,0 +1,299 @@
+package dynatrace
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// syntheticMonitorTag is a tag of a synthetic monitor as used by /synthetic/monitors.
+type syntheticMonitorTag struct {
+	Context string `json:"context,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// syntheticAnomalyDetection is the anomaly detection configuration shared by HTTP and browser monitors.
+type syntheticAnomalyDetection struct {
+	OutageHandling        syntheticOutageHandling        `json:"outageHandling"`
+	LoadingTimeThresholds syntheticLoadingTimeThresholds `json:"loadingTimeThresholds"`
+}
+
+type syntheticOutageHandling struct {
+	GlobalOutage      bool                       `json:"globalOutage"`
+	LocalOutage       bool                       `json:"localOutage"`
+	LocalOutagePolicy syntheticLocalOutagePolicy `json:"localOutagePolicy"`
+	RetryOnError      bool                       `json:"retryOnError"`
+}
+
+type syntheticLocalOutagePolicy struct {
+	AffectedLocations int `json:"affectedLocations"`
+	ConsecutiveRuns   int `json:"consecutiveRuns"`
+}
+
+type syntheticLoadingTimeThresholds struct {
+	Enabled    bool                            `json:"enabled"`
+	Thresholds []syntheticLoadingTimeThreshold `json:"thresholds"`
+}
+
+type syntheticLoadingTimeThreshold struct {
+	Type         string `json:"type"`
+	ValueMs      int    `json:"valueMs"`
+	RequestIndex int    `json:"requestIndex,omitempty"`
+	EventIndex   int    `json:"eventIndex,omitempty"`
+}
+
+func syntheticMonitorTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "A list of tags assigned to the synthetic monitor.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"context": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "CONTEXTLESS",
+					Description: "The origin of the tag, such as AWS or Cloud Foundry. Custom tags use the CONTEXTLESS value.",
+				},
+				"key": &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The key of the tag.",
+				},
+				"value": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value of the tag.",
+				},
+				"source": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "USER",
+					Description: "The source of the tag, such as USER, RULE_BASED or AUTO.",
+				},
+			},
+		},
+	}
+}
+
+func syntheticAnomalyDetectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "The anomaly detection configuration of the synthetic monitor.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"outage_handling": &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Outage handling configuration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"global_outage": &schema.Schema{
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "When enabled (true), generate a problem and send an alert when the monitor is unavailable at all configured locations.",
+							},
+							"local_outage": &schema.Schema{
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "When enabled (true), generate a problem and send an alert when the monitor is unavailable for one or more consecutive runs at any location.",
+							},
+							"affected_locations": &schema.Schema{
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     1,
+								Description: "The number of affected locations to trigger a local outage alert.",
+							},
+							"consecutive_runs": &schema.Schema{
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     3,
+								Description: "The number of consecutive failures to trigger a local outage alert.",
+							},
+							"retry_on_error": &schema.Schema{
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Schedule retry if the monitor fails (true) or not (false).",
+							},
+						},
+					},
+				},
+				"loading_time_thresholds": &schema.Schema{
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "Performance thresholds configuration.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": &schema.Schema{
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Performance threshold is enabled (true) or disabled (false).",
+							},
+							"threshold": &schema.Schema{
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The list of performance threshold rules.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"type": &schema.Schema{
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "The type of the threshold, either TOTAL or ACTION (browser) respectively REQUEST (HTTP).",
+										},
+										"value_ms": &schema.Schema{
+											Type:        schema.TypeInt,
+											Required:    true,
+											Description: "Notify if monitor takes longer than X milliseconds to load.",
+										},
+										"request_index": &schema.Schema{
+											Type:        schema.TypeInt,
+											Optional:    true,
+											Description: "Specify the request to which a REQUEST threshold applies.",
+										},
+										"event_index": &schema.Schema{
+											Type:        schema.TypeInt,
+											Optional:    true,
+											Description: "Specify the event to which an ACTION threshold applies.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandSyntheticMonitorTags(tags []interface{}) []syntheticMonitorTag {
+	if len(tags) < 1 {
+		return []syntheticMonitorTag{}
+	}
+
+	sts := make([]syntheticMonitorTag, len(tags))
+
+	for i, tag := range tags {
+
+		m := tag.(map[string]interface{})
+
+		sts[i] = syntheticMonitorTag{
+			Context: m["context"].(string),
+			Key:     m["key"].(string),
+			Value:   m["value"].(string),
+			Source:  m["source"].(string),
+		}
+	}
+
+	return sts
+}
+
+func expandSyntheticAnomalyDetection(anomalyDetection []interface{}) *syntheticAnomalyDetection {
+	if len(anomalyDetection) == 0 || anomalyDetection[0] == nil {
+		return nil
+	}
+
+	m := anomalyDetection[0].(map[string]interface{})
+
+	ad := &syntheticAnomalyDetection{}
+
+	if v, ok := m["outage_handling"]; ok && len(v.([]interface{})) != 0 && v.([]interface{})[0] != nil {
+		oh := v.([]interface{})[0].(map[string]interface{})
+
+		ad.OutageHandling = syntheticOutageHandling{
+			GlobalOutage: oh["global_outage"].(bool),
+			LocalOutage:  oh["local_outage"].(bool),
+			LocalOutagePolicy: syntheticLocalOutagePolicy{
+				AffectedLocations: oh["affected_locations"].(int),
+				ConsecutiveRuns:   oh["consecutive_runs"].(int),
+			},
+			RetryOnError: oh["retry_on_error"].(bool),
+		}
+	}
+
+	if v, ok := m["loading_time_thresholds"]; ok && len(v.([]interface{})) != 0 && v.([]interface{})[0] != nil {
+		ltt := v.([]interface{})[0].(map[string]interface{})
+
+		ad.LoadingTimeThresholds = syntheticLoadingTimeThresholds{
+			Enabled:    ltt["enabled"].(bool),
+			Thresholds: []syntheticLoadingTimeThreshold{},
+		}
+
+		for _, threshold := range ltt["threshold"].([]interface{}) {
+			t := threshold.(map[string]interface{})
+
+			ad.LoadingTimeThresholds.Thresholds = append(ad.LoadingTimeThresholds.Thresholds, syntheticLoadingTimeThreshold{
+				Type:         t["type"].(string),
+				ValueMs:      t["value_ms"].(int),
+				RequestIndex: t["request_index"].(int),
+				EventIndex:   t["event_index"].(int),
+			})
+		}
+	}
+
+	return ad
+}
+
+func flattenSyntheticMonitorTags(syntheticMonitorTags *[]syntheticMonitorTag) []interface{} {
+	if syntheticMonitorTags != nil {
+		sts := make([]interface{}, len(*syntheticMonitorTags), len(*syntheticMonitorTags))
+
+		for i, syntheticMonitorTag := range *syntheticMonitorTags {
+			st := make(map[string]interface{})
+
+			st["context"] = syntheticMonitorTag.Context
+			st["key"] = syntheticMonitorTag.Key
+			st["value"] = syntheticMonitorTag.Value
+			st["source"] = syntheticMonitorTag.Source
+			sts[i] = st
+		}
+
+		return sts
+	}
+
+	return make([]interface{}, 0)
+}
+
+func flattenSyntheticAnomalyDetection(anomalyDetection *syntheticAnomalyDetection) []interface{} {
+	if anomalyDetection == nil {
+		return make([]interface{}, 0)
+	}
+
+	oh := make(map[string]interface{})
+
+	oh["global_outage"] = anomalyDetection.OutageHandling.GlobalOutage
+	oh["local_outage"] = anomalyDetection.OutageHandling.LocalOutage
+	oh["affected_locations"] = anomalyDetection.OutageHandling.LocalOutagePolicy.AffectedLocations
+	oh["consecutive_runs"] = anomalyDetection.OutageHandling.LocalOutagePolicy.ConsecutiveRuns
+	oh["retry_on_error"] = anomalyDetection.OutageHandling.RetryOnError
+
+	ts := make([]interface{}, len(anomalyDetection.LoadingTimeThresholds.Thresholds))
+
+	for i, threshold := range anomalyDetection.LoadingTimeThresholds.Thresholds {
+		t := make(map[string]interface{})
+
+		t["type"] = threshold.Type
+		t["value_ms"] = threshold.ValueMs
+		t["request_index"] = threshold.RequestIndex
+		t["event_index"] = threshold.EventIndex
+		ts[i] = t
+	}
+
+	ltt := make(map[string]interface{})
+
+	ltt["enabled"] = anomalyDetection.LoadingTimeThresholds.Enabled
+	ltt["threshold"] = ts
+
+	ad := make(map[string]interface{})
+
+	ad["outage_handling"] = []interface{}{oh}
+	ad["loading_time_thresholds"] = []interface{}{ltt}
+
+	return []interface{}{ad}
+}
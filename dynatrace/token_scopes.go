@@ -0,0 +1,73 @@
+package dynatrace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// apiTokenScopes caches the scopes of the API token, which are looked up once per provider. Only successful lookups
+// and lookups denied with 403 are cached, other errors like timeouts are retried by the next check.
+type apiTokenScopes struct {
+	mutex    sync.Mutex
+	lookedUp bool
+	scopes   map[string]bool
+}
+
+// apiTokenLookup is the request and response body of /apiTokens/lookup.
+type apiTokenLookup struct {
+	Token  string   `json:"token,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// checkTokenScopes returns an error if the API token lacks any of the given scopes. If the scopes can't be
+// looked up, e.g. because the token lacks apiTokens.read, the check is skipped and the API decides.
+func checkTokenScopes(ctx context.Context, m interface{}, scopes ...string) diag.Diagnostics {
+	providerConf := m.(*ProviderConfiguration)
+	dynatraceEnvRestClientV2 := providerConf.DynatraceEnvRestClientV2
+	tokenScopes := providerConf.tokenScopes
+
+	tokenScopes.mutex.Lock()
+	defer tokenScopes.mutex.Unlock()
+
+	if !tokenScopes.lookedUp {
+		var lookup apiTokenLookup
+		err := dynatraceEnvRestClientV2.post(ctx, "/apiTokens/lookup", apiTokenLookup{Token: dynatraceEnvRestClientV2.apiToken}, &lookup)
+		if err != nil && !isForbidden(err) {
+			return nil
+		}
+
+		// Without apiTokens.read the scopes stay nil and every check is skipped
+		tokenScopes.lookedUp = true
+		if err == nil {
+			tokenScopes.scopes = make(map[string]bool)
+			for _, scope := range lookup.Scopes {
+				tokenScopes.scopes[scope] = true
+			}
+		}
+	}
+
+	if tokenScopes.scopes == nil {
+		return nil
+	}
+
+	missing := []string{}
+	for _, scope := range scopes {
+		if !tokenScopes.scopes[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Insufficient dynatrace API token scopes",
+			Detail:   fmt.Sprintf("The API token is missing the scopes %s", strings.Join(missing, ", ")),
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package dynatrace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckTokenScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []int
+		rejected  []bool
+		lookups   int
+	}{
+		// A failed lookup is retried, the check is skipped until the scopes are known
+		{"retry after server error", []int{http.StatusInternalServerError, http.StatusOK}, []bool{false, true, true}, 2},
+		// A token without apiTokens.read can't look up its scopes, every check is skipped
+		{"forbidden", []int{http.StatusForbidden}, []bool{false, false, false}, 1},
+		{"cached", []int{http.StatusOK}, []bool{true, true, true}, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lookups := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := test.responses[len(test.responses)-1]
+				if lookups < len(test.responses) {
+					status = test.responses[lookups]
+				}
+				lookups++
+
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					w.Write([]byte(`{"scopes": ["settings.read"]}`))
+				}
+			}))
+			defer server.Close()
+
+			providerConf := &ProviderConfiguration{
+				DynatraceEnvRestClientV2: newRestClient(server.URL, "token"),
+				tokenScopes:              &apiTokenScopes{},
+			}
+
+			for i, rejected := range test.rejected {
+				diags := checkTokenScopes(context.Background(), providerConf, "settings.read", "settings.write")
+				if diags.HasError() != rejected {
+					t.Errorf("check %d: expected rejected %t, got %v", i, rejected, diags)
+				}
+			}
+
+			if lookups != test.lookups {
+				t.Errorf("expected %d lookups, got %d", test.lookups, lookups)
+			}
+		})
+	}
+}
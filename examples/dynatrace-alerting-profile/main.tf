@@ -11,7 +11,7 @@ data "dynatrace_alerting_profiles" "keptn" {
 resource "dynatrace_alerting_profiles" "sockshop_errors" {
 
   display_name = "sockshop_errors"
-  mz_id = dynatrace_management_zones.sockshop_prod.id
+  mz_id = dynatrace_management_zones.sockshop_prod.legacy_id
 
   rule{
     severity_level = "AVAILABILITY"
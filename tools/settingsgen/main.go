@@ -0,0 +1,527 @@
+// Command settingsgen generates typed Terraform resources from Dynatrace settings schemas.
+//
+// It reads every settings schema JSON file of the schemas directory, as returned by the Environment v2 API
+// /settings/schemas/{schemaId}, and writes one resource_dynatrace_<name>_settings_gen.go file per schema
+// into the output directory. Each file contains the schema of the resource and its nested blocks, together
+// with the expand and flatten functions, which convert between the resource data and the settings value.
+// The CRUD operations are implemented once by the settingsResource of the dynatrace package.
+//
+// Usage:
+//
+//	go run ./tools/settingsgen -schemas settings/schemas -out dynatrace
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// settingsSchema is the part of a settings schema which is needed to generate a resource.
+type settingsSchema struct {
+	SchemaID    string                    `json:"schemaId"`
+	Version     string                    `json:"version"`
+	DisplayName string                    `json:"displayName"`
+	Properties  map[string]schemaProperty `json:"properties"`
+	Types       map[string]schemaType     `json:"types"`
+	Enums       map[string]schemaEnum     `json:"enums"`
+}
+
+type schemaProperty struct {
+	DisplayName  string          `json:"displayName"`
+	Description  string          `json:"description"`
+	Type         json.RawMessage `json:"type"`
+	Items        *schemaItems    `json:"items"`
+	Nullable     bool            `json:"nullable"`
+	Default      interface{}     `json:"default"`
+	Precondition interface{}     `json:"precondition"`
+}
+
+type schemaItems struct {
+	Type json.RawMessage `json:"type"`
+}
+
+type schemaType struct {
+	Properties map[string]schemaProperty `json:"properties"`
+}
+
+type schemaEnum struct {
+	Items []struct {
+		Value interface{} `json:"value"`
+	} `json:"items"`
+}
+
+// generator writes the Go source of a single settings schema.
+type generator struct {
+	schema *settingsSchema
+	prefix string
+	buf    bytes.Buffer
+}
+
+func main() {
+	schemas := flag.String("schemas", "settings/schemas", "directory of the settings schema JSON files")
+	out := flag.String("out", "dynatrace", "directory of the generated Go files")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*schemas, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, file := range files {
+		if err := generate(file, *out); err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+	}
+}
+
+func generate(file string, out string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var s settingsSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s.SchemaID == "" {
+		return fmt.Errorf("missing schemaId")
+	}
+
+	name := schemaName(s.SchemaID)
+	g := &generator{schema: &s, prefix: name + "Settings"}
+	if err := g.generate(); err != nil {
+		return err
+	}
+
+	source, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated source: %s", err)
+	}
+
+	target := filepath.Join(out, "resource_dynatrace_"+snakeCase(name)+"_settings_gen.go")
+	return ioutil.WriteFile(target, source, os.FileMode(0644))
+}
+
+func (g *generator) generate() error {
+	s := g.schema
+	lower := lowerFirst(g.prefix)
+
+	g.printf("// Code generated by settingsgen from %s %s. DO NOT EDIT.\n\n", s.SchemaID, s.Version)
+	g.printf("package dynatrace\n\n")
+	g.printf("import (\n")
+	g.printf("\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema\"\n")
+	if g.usesValidation() {
+		g.printf("\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation\"\n")
+	}
+	g.printf(")\n\n")
+
+	g.printf("// %s manages the objects of the settings schema %s.\n", lower, s.SchemaID)
+	g.printf("var %s = &settingsResource{\n", lower)
+	g.printf("SchemaID: %q,\n", s.SchemaID)
+	g.printf("SchemaVersion: %q,\n", s.Version)
+	g.printf("Schema: %sSchema,\n", lower)
+	g.printf("Expand: expand%s,\n", g.prefix)
+	g.printf("Flatten: flatten%sData,\n", g.prefix)
+	g.printf("}\n\n")
+
+	g.printf("func resourceDynatrace%s() *schema.Resource {\n", g.prefix)
+	g.printf("return %s.resource()\n", lower)
+	g.printf("}\n\n")
+
+	if err := g.generateType("", s.Properties); err != nil {
+		return err
+	}
+
+	for _, typeName := range sortedKeys(s.Types) {
+		if err := g.generateType(typeName, s.Types[typeName].Properties); err != nil {
+			return fmt.Errorf("type %s: %s", typeName, err)
+		}
+	}
+
+	return nil
+}
+
+// generateType writes the schema, expand and flatten functions of the root properties or of a type of the schema.
+func (g *generator) generateType(typeName string, properties map[string]schemaProperty) error {
+	name := g.prefix + goName(typeName)
+	names := sortedKeys(properties)
+
+	g.printf("func %sSchema() map[string]*schema.Schema {\n", lowerFirst(name))
+	g.printf("return map[string]*schema.Schema{\n")
+	for _, property := range names {
+		if err := g.generateSchema(property, properties[property]); err != nil {
+			return fmt.Errorf("property %s: %s", property, err)
+		}
+	}
+	g.printf("}\n")
+	g.printf("}\n\n")
+
+	g.printf("func expand%s(s settingsData) map[string]interface{} {\n", name)
+	g.printf("value := map[string]interface{}{}\n\n")
+	for _, property := range names {
+		g.generateExpand(property, properties[property])
+	}
+	g.printf("\nreturn value\n")
+	g.printf("}\n\n")
+
+	g.printf("func flatten%sData(value map[string]interface{}) map[string]interface{} {\n", name)
+	g.printf("m := make(map[string]interface{})\n\n")
+	for _, property := range names {
+		g.generateFlatten(property, properties[property])
+	}
+	g.printf("\nreturn m\n")
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) generateSchema(name string, p schemaProperty) error {
+	kind, ref, err := g.kind(p.Type)
+	if err != nil {
+		return err
+	}
+
+	g.printf("%q: &schema.Schema{\n", attributeName(name))
+
+	switch kind {
+	case "list", "set":
+		if p.Items == nil {
+			return fmt.Errorf("missing items of %s", kind)
+		}
+		itemKind, itemRef, err := g.kind(p.Items.Type)
+		if err != nil {
+			return err
+		}
+		switch {
+		case itemKind == "type":
+			g.printf("Type: schema.TypeList,\n")
+		case kind == "set":
+			g.printf("Type: schema.TypeSet,\n")
+		default:
+			g.printf("Type: schema.TypeList,\n")
+		}
+		g.printOptionality(p, false)
+		g.printDescription(p)
+		switch itemKind {
+		case "type":
+			g.printf("Elem: &schema.Resource{\nSchema: %sSchema(),\n},\n", lowerFirst(g.prefix+goName(itemRef)))
+		case "list", "set":
+			return fmt.Errorf("nested %s of %s isn't supported", itemKind, kind)
+		default:
+			g.printf("Elem: &schema.Schema{\nType: %s,\n", terraformType(itemKind))
+			if itemKind == "enum" {
+				g.printf("ValidateFunc: %s,\n", g.enumValidation(itemRef))
+			}
+			g.printf("},\n")
+		}
+	case "type":
+		g.printf("Type: schema.TypeList,\n")
+		g.printOptionality(p, false)
+		if g.required(p) {
+			g.printf("MinItems: 1,\n")
+		}
+		g.printf("MaxItems: 1,\n")
+		g.printDescription(p)
+		g.printf("Elem: &schema.Resource{\nSchema: %sSchema(),\n},\n", lowerFirst(g.prefix+goName(ref)))
+	default:
+		g.printf("Type: %s,\n", terraformType(kind))
+		g.printOptionality(p, true)
+		if kind == "secret" {
+			g.printf("Sensitive: true,\n")
+		}
+		g.printDescription(p)
+		if kind == "enum" {
+			g.printf("ValidateFunc: %s,\n", g.enumValidation(ref))
+		}
+	}
+
+	g.printf("},\n")
+
+	return nil
+}
+
+func (g *generator) generateExpand(name string, p schemaProperty) {
+	kind, ref, _ := g.kind(p.Type)
+	attribute := attributeName(name)
+
+	var expr string
+	switch kind {
+	case "list", "set":
+		itemKind, itemRef, _ := g.kind(p.Items.Type)
+		if itemKind == "type" {
+			expr = fmt.Sprintf("expandSettingsList(s, %q, expand%s)", attribute, g.prefix+goName(itemRef))
+		} else {
+			expr = fmt.Sprintf("expandSettingsPrimitives(s.get(%q))", attribute)
+		}
+	case "type":
+		expr = fmt.Sprintf("expandSettingsBlock(s, %q, expand%s)", attribute, g.prefix+goName(ref))
+	default:
+		expr = fmt.Sprintf("s.get(%q)", attribute)
+	}
+
+	// Properties without a value are left out, so the API applies its defaults and hidden properties aren't sent.
+	// Primitives are sent if they are configured, which includes zero values like false, lists and blocks if they aren't empty.
+	switch {
+	case g.required(p) || g.hasDefault(p, kind):
+		g.printf("value[%q] = %s\n", name, expr)
+	case kind == "list" || kind == "set" || kind == "type":
+		g.printf("setSettingsOptional(value, %q, %s)\n", name, expr)
+	default:
+		g.printf("if s.configured(%q) {\nvalue[%q] = %s\n}\n", attribute, name, expr)
+	}
+}
+
+func (g *generator) generateFlatten(name string, p schemaProperty) {
+	kind, ref, _ := g.kind(p.Type)
+	attribute := attributeName(name)
+
+	switch kind {
+	case "secret":
+		g.printf("// %s is a secret, which is masked by the API and kept from the state\n", attribute)
+	case "list", "set":
+		itemKind, itemRef, _ := g.kind(p.Items.Type)
+		if itemKind == "type" {
+			g.printf("m[%q] = flattenSettingsList(value[%q], flatten%sData)\n", attribute, name, g.prefix+goName(itemRef))
+		} else {
+			g.printf("m[%q] = flattenSettingsPrimitives(value[%q])\n", attribute, name)
+		}
+	case "type":
+		g.printf("m[%q] = flattenSettingsBlock(value[%q], flatten%sData)\n", attribute, name, g.prefix+goName(ref))
+	default:
+		g.printf("m[%q] = value[%q]\n", attribute, name)
+	}
+}
+
+// kind returns the kind of a property type, i.e. a primitive type like text or boolean, or type or enum together with
+// the name of the referenced type or enum.
+func (g *generator) kind(raw json.RawMessage) (string, string, error) {
+	var primitive string
+	if err := json.Unmarshal(raw, &primitive); err == nil {
+		return primitive, "", nil
+	}
+
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if err := json.Unmarshal(raw, &ref); err != nil {
+		return "", "", fmt.Errorf("unsupported type %s", string(raw))
+	}
+
+	switch {
+	case strings.HasPrefix(ref.Ref, "#/types/"):
+		typeName := strings.TrimPrefix(ref.Ref, "#/types/")
+		if _, ok := g.schema.Types[typeName]; !ok {
+			return "", "", fmt.Errorf("unknown type %s", typeName)
+		}
+		return "type", typeName, nil
+	case strings.HasPrefix(ref.Ref, "#/enums/"):
+		enumName := strings.TrimPrefix(ref.Ref, "#/enums/")
+		if _, ok := g.schema.Enums[enumName]; !ok {
+			return "", "", fmt.Errorf("unknown enum %s", enumName)
+		}
+		return "enum", enumName, nil
+	}
+
+	return "", "", fmt.Errorf("unsupported reference %s", ref.Ref)
+}
+
+// required reports whether a property must be configured. Properties with a precondition may be hidden depending on
+// other properties, so they are always optional.
+func (g *generator) required(p schemaProperty) bool {
+	return !p.Nullable && p.Default == nil && p.Precondition == nil
+}
+
+// hasDefault reports whether the default of a property is used as default of the attribute.
+func (g *generator) hasDefault(p schemaProperty, kind string) bool {
+	if p.Default == nil || p.Precondition != nil {
+		return false
+	}
+
+	switch kind {
+	case "boolean", "integer", "float", "text", "enum":
+		return true
+	}
+
+	return false
+}
+
+func (g *generator) printOptionality(p schemaProperty, withDefault bool) {
+	kind, _, _ := g.kind(p.Type)
+
+	switch {
+	case g.required(p):
+		g.printf("Required: true,\n")
+	case withDefault && g.hasDefault(p, kind):
+		g.printf("Optional: true,\n")
+		if kind == "integer" {
+			g.printf("Default: %d,\n", int64(p.Default.(float64)))
+		} else {
+			g.printf("Default: %#v,\n", p.Default)
+		}
+	case p.Default != nil:
+		// The API applies the default, which is only known after the object is created
+		g.printf("Optional: true,\n")
+		g.printf("Computed: true,\n")
+	default:
+		g.printf("Optional: true,\n")
+	}
+}
+
+func (g *generator) printDescription(p schemaProperty) {
+	description := p.Description
+	if description == "" {
+		description = p.DisplayName
+	}
+	if description != "" {
+		g.printf("Description: %q,\n", description)
+	}
+}
+
+func (g *generator) enumValidation(enumName string) string {
+	values := []string{}
+	for _, item := range g.schema.Enums[enumName].Items {
+		values = append(values, fmt.Sprintf("%q", fmt.Sprint(item.Value)))
+	}
+
+	return fmt.Sprintf("validation.StringInSlice([]string{%s}, false)", strings.Join(values, ", "))
+}
+
+func (g *generator) usesValidation() bool {
+	uses := func(properties map[string]schemaProperty) bool {
+		for _, p := range properties {
+			if kind, _, _ := g.kind(p.Type); kind == "enum" {
+				return true
+			}
+			if p.Items != nil {
+				if kind, _, _ := g.kind(p.Items.Type); kind == "enum" {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if uses(g.schema.Properties) {
+		return true
+	}
+	for _, t := range g.schema.Types {
+		if uses(t.Properties) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// terraformType returns the Terraform type of a primitive kind, types like local_time or time_zone are strings.
+func terraformType(kind string) string {
+	switch kind {
+	case "boolean":
+		return "schema.TypeBool"
+	case "integer":
+		return "schema.TypeInt"
+	case "float":
+		return "schema.TypeFloat"
+	}
+
+	return "schema.TypeString"
+}
+
+// schemaName returns the Go name of a schema ID, e.g. AlertingProfile for builtin:alerting.profile.
+func schemaName(schemaID string) string {
+	if i := strings.Index(schemaID, ":"); i >= 0 {
+		schemaID = schemaID[i+1:]
+	}
+
+	return goName(schemaID)
+}
+
+// goName returns the exported Go name of an identifier, e.g. ManagementZones for management-zones.
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// attributeName returns the Terraform attribute of a property. id and scope are reserved by the resource.
+func attributeName(property string) string {
+	attribute := snakeCase(property)
+	if attribute == "id" || attribute == "scope" {
+		attribute += "_value"
+	}
+
+	return attribute
+}
+
+// snakeCase converts a camel case identifier to snake case, e.g. delayInMinutes to delay_in_minutes and mzURL to mz_url.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		} else if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	switch v := m.(type) {
+	case map[string]schemaProperty:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]schemaType:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the generator")
+
+// TestGenerateGolden generates the sample schema of settings/schemas/testdata and compares the result with the
+// golden file next to it. Run go test ./tools/settingsgen -update after an intended change of the generated code.
+func TestGenerateGolden(t *testing.T) {
+	testdata := filepath.Join("..", "..", "settings", "schemas", "testdata")
+	out, err := ioutil.TempDir("", "settingsgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+
+	if err := generate(filepath.Join(testdata, "sample.json"), out); err != nil {
+		t.Fatal(err)
+	}
+
+	const name = "resource_dynatrace_settingsgen_sample_settings_gen.go"
+	generated, err := ioutil.ReadFile(filepath.Join(out, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden := filepath.Join(testdata, name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, generated, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(generated) != string(expected) {
+		t.Errorf("generated code differs from %s, run go test ./tools/settingsgen -update if the change is intended:\n%s", golden, generated)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"name":           "name",
+		"delayInMinutes": "delay_in_minutes",
+		"mzURL":          "mz_url",
+		"URLPattern":     "url_pattern",
+		"http2Enabled":   "http2_enabled",
+		"pgToHost":       "pg_to_host",
+		"tag-filter":     "tag_filter",
+	}
+
+	for name, expected := range tests {
+		if actual := snakeCase(name); actual != expected {
+			t.Errorf("snakeCase(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"":                 "",
+		"Rule":             "Rule",
+		"management-zones": "ManagementZones",
+		"alerting.profile": "AlertingProfile",
+		"severityRules":    "SeverityRules",
+		"http_check2":      "HttpCheck2",
+	}
+
+	for name, expected := range tests {
+		if actual := goName(name); actual != expected {
+			t.Errorf("goName(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+
+	if actual := schemaName("builtin:alerting.profile"); actual != "AlertingProfile" {
+		t.Errorf("schemaName: expected AlertingProfile, got %q", actual)
+	}
+}
+
+func TestAttributeName(t *testing.T) {
+	tests := map[string]string{
+		"id":             "id_value",
+		"scope":          "scope_value",
+		"delayInMinutes": "delay_in_minutes",
+	}
+
+	for property, expected := range tests {
+		if actual := attributeName(property); actual != expected {
+			t.Errorf("attributeName(%q): expected %q, got %q", property, expected, actual)
+		}
+	}
+}